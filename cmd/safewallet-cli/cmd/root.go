@@ -4,10 +4,14 @@ Copyright © 2024 pando
 package cmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
 
+	"github.com/pandodao/safe-wallet/handler/auth"
 	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -31,10 +35,71 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().StringP("endpoint", "l", "http://localhost:8080", "rpc endpoint")
 	viper.BindPFlag("endpoint", rootCmd.PersistentFlags().Lookup("endpoint"))
+	rootCmd.PersistentFlags().String("api-key", "", "api key, defaults to $SAFEWALLET_API_KEY")
+	viper.BindPFlag("api_key", rootCmd.PersistentFlags().Lookup("api-key"))
+	viper.BindEnv("api_key", "SAFEWALLET_API_KEY")
+	rootCmd.PersistentFlags().Uint64("api-key-id", 0, "api key id, requests are signed if set, defaults to $SAFEWALLET_API_KEY_ID")
+	viper.BindPFlag("api_key_id", rootCmd.PersistentFlags().Lookup("api-key-id"))
+	viper.BindEnv("api_key_id", "SAFEWALLET_API_KEY_ID")
+	rootCmd.PersistentFlags().String("signing-key", "", "hex signing key of the api key id, defaults to $SAFEWALLET_SIGNING_KEY")
+	viper.BindPFlag("signing_key", rootCmd.PersistentFlags().Lookup("signing-key"))
+	viper.BindEnv("signing_key", "SAFEWALLET_SIGNING_KEY")
+	rootCmd.PersistentFlags().String("cert", "", "client certificate file for mutual tls")
+	viper.BindPFlag("cert", rootCmd.PersistentFlags().Lookup("cert"))
+	rootCmd.PersistentFlags().String("key", "", "client certificate key file for mutual tls")
+	viper.BindPFlag("key", rootCmd.PersistentFlags().Lookup("key"))
+	rootCmd.PersistentFlags().String("cacert", "", "ca certificate file to verify the server, system roots if empty")
+	viper.BindPFlag("cacert", rootCmd.PersistentFlags().Lookup("cacert"))
 }
 
 func getTwirpClient() safewallet.SafeWalletService {
-	return safewallet.NewSafeWalletServiceProtobufClient(viper.GetString("endpoint"), http.DefaultClient)
+	transport, err := newHTTPTransport()
+	cobra.CheckErr(err)
+
+	t := &auth.Transport{
+		KeyID:  viper.GetUint64("api_key_id"),
+		Secret: viper.GetString("api_key"),
+		Base:   transport,
+	}
+
+	if signingKey := viper.GetString("signing_key"); signingKey != "" {
+		t.SigningKey, err = auth.ParseSigningKey(signingKey)
+		cobra.CheckErr(err)
+	}
+
+	client := &http.Client{Transport: t}
+
+	return safewallet.NewSafeWalletServiceProtobufClient(viper.GetString("endpoint"), client)
+}
+
+func newHTTPTransport() (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if certFile := viper.GetString("cert"); certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, viper.GetString("key"))
+		if err != nil {
+			return nil, err
+		}
+
+		t.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile := viper.GetString("cacert"); caFile != "" {
+		b, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates in %s", caFile)
+		}
+
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
 }
 
 func printJson(cmd *cobra.Command, v any) error {
@@ -24,6 +24,9 @@ func init() {
 	rootCmd.AddCommand(walletCmd)
 
 	walletCmd.Flags().StringVar(&walletOpt.Label, "label", "", "label")
+	walletCmd.Flags().StringVar(&walletOpt.RequestId, "request", "", "request id (optional)")
+	walletCmd.Flags().StringVar(&walletOpt.ExternalId, "external", "", "external id (optional)")
+	walletCmd.Flags().StringVar(&walletOpt.Metadata, "metadata", "", "metadata json object (optional)")
 }
 
 func createWallet(cmd *cobra.Command, req *safewallet.CreateWalletRequest) error {
@@ -1,21 +1,40 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"expvar"
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/wire"
+	"github.com/pandodao/safe-wallet/core"
 	"github.com/pandodao/safe-wallet/handler/api"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/certs"
 	"github.com/pandodao/safe-wallet/handler/hc"
+	"github.com/pandodao/safe-wallet/handler/ratelimit"
 	"github.com/pandodao/safe-wallet/handler/rpc"
+	ratelimitstore "github.com/pandodao/safe-wallet/store/ratelimit"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
+	"github.com/tsenart/nap"
 )
 
 var serverSet = wire.NewSet(
+	provideAuthConfig,
+	provideTLSConfig,
+	provideRateLimitConfig,
+	provideRateLimiter,
+	ratelimit.New,
 	provideRpcConfig,
 	rpc.New,
 	api.New,
@@ -32,19 +51,157 @@ func provideRpcConfig(ks *mixin.Keystore) rpc.Config {
 	}
 }
 
-func provideServer(apiHandler *api.Server, rpcHandler *rpc.Server) *http.Server {
+func provideAuthConfig(v *viper.Viper) (auth.Config, error) {
+	v.SetDefault("auth.max_skew", 5*time.Minute)
+	v.SetDefault("auth.max_body_size", 1<<20)
+
+	cfg := auth.Config{
+		MaxSkew:     v.GetDuration("auth.max_skew"),
+		MaxBodySize: v.GetInt64("auth.max_body_size"),
+	}
+
+	if err := v.UnmarshalKey("tls.identities", &cfg.Identities); err != nil {
+		return cfg, err
+	}
+
+	for idx, id := range cfg.Identities {
+		if id.Subject == "" {
+			return cfg, fmt.Errorf("tls identity %d: subject required", idx)
+		}
+
+		if !strings.Contains(id.Subject, "=") {
+			return cfg, fmt.Errorf("tls identity %s: subject must be the full distinguished name, e.g. CN=%s,O=org", id.Subject, id.Subject)
+		}
+
+		if id.Name == "" {
+			cfg.Identities[idx].Name = id.Subject
+		}
+
+		for _, scope := range id.Scopes {
+			if !slices.Contains(core.Scopes, scope) {
+				return cfg, fmt.Errorf("tls identity %s: invalid scope %q", id.Subject, scope)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// provideTLSConfig returns nil if no certificate is configured, the server runs plain http then
+func provideTLSConfig(v *viper.Viper, logger *slog.Logger) (*tls.Config, error) {
+	cfg := certs.Config{
+		CertFile:          v.GetString("tls.cert_file"),
+		KeyFile:           v.GetString("tls.key_file"),
+		ClientCAFile:      v.GetString("tls.client_ca_file"),
+		RequireClientCert: v.GetBool("tls.require_client_cert"),
+	}
+
+	if cfg.CertFile == "" {
+		return nil, nil
+	}
+
+	return certs.NewTLSConfig(cfg, logger)
+}
+
+func provideRateLimitConfig(v *viper.Viper) (ratelimit.Config, error) {
+	v.SetDefault("ratelimit.ip.rate", 50)
+	v.SetDefault("ratelimit.ip.burst", 100)
+	v.SetDefault("ratelimit.key.rate", 10)
+	v.SetDefault("ratelimit.key.burst", 20)
+	v.SetDefault("ratelimit.methods.createwallet.rate", 1)
+	v.SetDefault("ratelimit.methods.createwallet.burst", 5)
+	v.SetDefault("ratelimit.methods.createtransfer.rate", 5)
+	v.SetDefault("ratelimit.methods.createtransfer.burst", 10)
+
+	cfg := ratelimit.Config{
+		IP:      getRateLimit(v, "ratelimit.ip"),
+		Key:     getRateLimit(v, "ratelimit.key"),
+		Methods: map[string]core.RateLimit{},
+	}
+
+	methods := v.GetStringMap("ratelimit.methods")
+	methods["createwallet"] = nil
+	methods["createtransfer"] = nil
+	for method := range methods {
+		cfg.Methods[method] = getRateLimit(v, "ratelimit.methods."+method)
+	}
+
+	for _, cidr := range v.GetStringSlice("ratelimit.trusted_proxies") {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return cfg, fmt.Errorf("ratelimit trusted proxy %q: %w", cidr, err)
+		}
+
+		cfg.TrustedProxies = append(cfg.TrustedProxies, network)
+	}
+
+	return cfg, nil
+}
+
+func getRateLimit(v *viper.Viper, key string) core.RateLimit {
+	return core.RateLimit{
+		Rate:  v.GetFloat64(key + ".rate"),
+		Burst: v.GetInt(key + ".burst"),
+	}
+}
+
+// provideRateLimiter keeps the buckets in memory, or in the database to share them between replicas
+func provideRateLimiter(v *viper.Viper, db *nap.DB) (core.RateLimiter, error) {
+	v.SetDefault("ratelimit.backend", "memory")
+
+	switch backend := v.GetString("ratelimit.backend"); backend {
+	case "memory":
+		return ratelimit.NewMemory(100000), nil
+	case "db":
+		return ratelimit.NewShared(ratelimit.NewMemory(100000), ratelimitstore.New(db)), nil
+	default:
+		return nil, fmt.Errorf("unknown ratelimit backend %q", backend)
+	}
+}
+
+func provideServer(
+	apiHandler *api.Server,
+	rpcHandler *rpc.Server,
+	wallets core.WalletStore,
+	keys core.APIKeyStore,
+	nonces core.NonceStore,
+	logger *slog.Logger,
+	authConfig auth.Config,
+	limiter *ratelimit.Limiter,
+	tlsConfig *tls.Config,
+) *http.Server {
+	// the client ip is resolved by the limiter, only from trusted proxies
 	m := chi.NewMux()
-	m.Use(middleware.RealIP)
 	m.Use(middleware.Logger)
 	m.Use(middleware.Recoverer)
 	m.Use(cors.AllowAll().Handler)
 
-	m.Mount("/api", apiHandler.Handler())
-	m.Mount(rpcHandler.Handler())
-	m.Mount("/hc", hc.Handler(version))
+	// health checks are not limited, a busy client ip must not fail the probes
+	authenticate := auth.Middleware(keys, nonces, logger, authConfig)
+	m.With(limiter.Middleware, authenticate).Mount("/api", apiHandler.Handler())
+	m.With(limiter.Middleware, authenticate).Mount(rpcHandler.Handler())
+	m.Mount("/hc", hc.Handler(version, map[string]hc.Probe{
+		"wallet_pool": func(ctx context.Context) (any, error) {
+			return wallets.PoolStats(ctx)
+		},
+	}))
+
+	return &http.Server{
+		Addr:      fmt.Sprintf(":%d", opt.port),
+		Handler:   m,
+		TLSConfig: tlsConfig,
+	}
+}
+
+// newMetricsServer serves the expvar metrics on the internal listener, they are
+// not exposed on the api port
+func newMetricsServer(addr string) *http.Server {
+	m := chi.NewMux()
+	m.Use(middleware.Recoverer)
+	m.Mount("/metrics", expvar.Handler())
 
 	return &http.Server{
-		Addr:    fmt.Sprintf(":%d", opt.port),
+		Addr:    addr,
 		Handler: m,
 	}
 }
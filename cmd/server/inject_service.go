@@ -4,8 +4,10 @@ import (
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/google/wire"
+	"github.com/pandodao/safe-wallet/service/escrow"
 	"github.com/pandodao/safe-wallet/service/loader"
 	"github.com/pandodao/safe-wallet/service/output"
+	"github.com/pandodao/safe-wallet/service/sweep"
 	"github.com/pandodao/safe-wallet/service/wallet"
 	"github.com/spf13/viper"
 )
@@ -17,6 +19,9 @@ var serviceSet = wire.NewSet(
 	output.New,
 	wallet.New,
 	loader.New,
+	provideSweepConfig,
+	sweep.New,
+	escrow.New,
 )
 
 func provideKeystore(v *viper.Viper) *mixin.Keystore {
@@ -36,3 +41,16 @@ func provideSpendKey() mixinnet.Key {
 	// server is not allowed to sign transactions
 	return mixinnet.Key{}
 }
+
+func provideSweepConfig(v *viper.Viper, ks *mixin.Keystore) (sweep.Config, error) {
+	cfg := sweep.Config{
+		ClientID:           ks.ClientID,
+		ArchiveDestination: v.GetString("sweep.archive_destination"),
+	}
+
+	if err := v.UnmarshalKey("sweep.rules", &cfg.Rules); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
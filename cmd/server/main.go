@@ -18,10 +18,11 @@ import (
 
 var (
 	opt struct {
-		config  string
-		port    int
-		debug   bool
-		version bool
+		config      string
+		port        int
+		metricsAddr string
+		debug       bool
+		version     bool
 	}
 
 	version = "0.0.1-src"
@@ -31,6 +32,7 @@ var (
 func main() {
 	flag.StringVar(&opt.config, "config", "config.yaml", "config file path")
 	flag.IntVar(&opt.port, "port", 8080, "server port")
+	flag.StringVar(&opt.metricsAddr, "metrics-addr", "127.0.0.1:9090", "internal listener of /metrics, disabled if empty")
 	flag.BoolVar(&opt.debug, "debug", false, "debug mode")
 	flag.BoolVar(&opt.version, "version", false, "show version")
 	flag.Parse()
@@ -54,11 +56,16 @@ func main() {
 
 	defer cleanup()
 
-	logger.Info("safe wallet server launched", "version", version, "commit", commit, "addr", app.svr.Addr)
+	logger.Info("safe wallet server launched", "version", version, "commit", commit, "addr", app.svr.Addr, "tls", app.svr.TLSConfig != nil)
 
 	g, ctx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
+		// the certificates are served by the tls config, which reloads them
+		if app.svr.TLSConfig != nil {
+			return app.svr.ListenAndServeTLS("", "")
+		}
+
 		return app.svr.ListenAndServe()
 	})
 
@@ -67,6 +74,16 @@ func main() {
 		return app.svr.Shutdown(ctx)
 	})
 
+	if opt.metricsAddr != "" {
+		metrics := newMetricsServer(opt.metricsAddr)
+
+		g.Go(metrics.ListenAndServe)
+		g.Go(func() error {
+			<-ctx.Done()
+			return metrics.Shutdown(ctx)
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		logger.Error("server exit", "err", err)
 	}
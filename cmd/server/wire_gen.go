@@ -8,9 +8,20 @@ package main
 
 import (
 	"github.com/pandodao/safe-wallet/handler/api"
+	"github.com/pandodao/safe-wallet/handler/ratelimit"
 	"github.com/pandodao/safe-wallet/handler/rpc"
+	escrow2 "github.com/pandodao/safe-wallet/service/escrow"
+	"github.com/pandodao/safe-wallet/service/sweep"
 	wallet2 "github.com/pandodao/safe-wallet/service/wallet"
+	"github.com/pandodao/safe-wallet/store/apikey"
+	"github.com/pandodao/safe-wallet/store/audit"
+	"github.com/pandodao/safe-wallet/store/escrow"
+	"github.com/pandodao/safe-wallet/store/invoice"
+	"github.com/pandodao/safe-wallet/store/ledger"
+	"github.com/pandodao/safe-wallet/store/nonce"
 	"github.com/pandodao/safe-wallet/store/output"
+	"github.com/pandodao/safe-wallet/store/route"
+	"github.com/pandodao/safe-wallet/store/topup"
 	"github.com/pandodao/safe-wallet/store/transfer"
 	"github.com/pandodao/safe-wallet/store/wallet"
 	"github.com/spf13/viper"
@@ -38,11 +49,60 @@ func setupApp(v *viper.Viper, logger *slog.Logger) (app, func(), error) {
 		cleanup()
 		return app{}, nil, err
 	}
-	walletService := wallet2.New(client)
-	config := provideRpcConfig(keystore)
-	server := rpc.New(outputStore, transferStore, walletStore, walletService, logger, config)
+	walletService := wallet2.New(client, walletStore)
+	ledgerStore := ledger.New(db)
+	config, err := provideSweepConfig(v, keystore)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	sweepService, err := sweep.New(outputStore, transferStore, walletStore, walletService, ledgerStore, config)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	topupStore := topup.New(db)
+	auditKey, err := provideAuditKey(v)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	auditStore := audit.New(db, auditKey)
+	invoiceStore := invoice.New(db)
+	routeStore := route.New(db)
+	escrowStore := escrow.New(db)
+	escrowService := escrow2.New(outputStore, transferStore, escrowStore)
+	apiKeyStore := apikey.New(db)
+	rateLimiter, err := provideRateLimiter(v, db)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	ratelimitConfig, err := provideRateLimitConfig(v)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	limiter := ratelimit.New(rateLimiter, logger, ratelimitConfig)
+	rpcConfig := provideRpcConfig(keystore)
+	server, err := rpc.New(outputStore, transferStore, walletStore, walletService, sweepService, topupStore, auditStore, invoiceStore, routeStore, ledgerStore, escrowStore, escrowService, apiKeyStore, limiter, logger, rpcConfig)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
 	apiServer := api.New(server)
-	httpServer := provideServer(apiServer, server)
+	nonceStore := nonce.New(db)
+	authConfig, err := provideAuthConfig(v)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	tlsConfig, err := provideTLSConfig(v, logger)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	httpServer := provideServer(apiServer, server, walletStore, apiKeyStore, nonceStore, logger, authConfig, limiter, tlsConfig)
 	mainApp := app{
 		svr:    httpServer,
 		logger: logger,
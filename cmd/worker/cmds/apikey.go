@@ -0,0 +1,122 @@
+package cmds
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/spf13/cobra"
+)
+
+func (c *Cmd) apiKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "manage api keys",
+	}
+
+	cmd.AddCommand(c.createAPIKeyCmd())
+	cmd.AddCommand(c.listAPIKeysCmd())
+	cmd.AddCommand(c.revokeAPIKeyCmd())
+	return cmd
+}
+
+func (c *Cmd) createAPIKeyCmd() *cobra.Command {
+	var key core.APIKey
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create an api key, the secret & signing key are only printed once",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if key.Name == "" {
+				return fmt.Errorf("name required")
+			}
+
+			if len(key.Scopes) == 0 {
+				return fmt.Errorf("at least one scope required")
+			}
+
+			for _, scope := range key.Scopes {
+				if !slices.Contains(core.Scopes, scope) {
+					return fmt.Errorf("invalid scope %q, must be one of %v", scope, core.Scopes)
+				}
+			}
+
+			for _, id := range key.WalletIDs {
+				if _, err := uuid.Parse(id); err != nil {
+					return fmt.Errorf("invalid wallet id %q", id)
+				}
+			}
+
+			b := make([]byte, 32)
+			if _, err := rand.Read(b); err != nil {
+				return err
+			}
+
+			secret := "sk_" + hex.EncodeToString(b)
+			output := map[string]any{"secret": secret}
+
+			// only the public key is stored, the signing key is generated here unless
+			// the client brings its own public key
+			if key.SigningKey == "" {
+				pub, priv, err := ed25519.GenerateKey(rand.Reader)
+				if err != nil {
+					return err
+				}
+
+				key.SigningKey = hex.EncodeToString(pub)
+				output["signing_key"] = hex.EncodeToString(priv.Seed())
+			} else if pub, err := hex.DecodeString(key.SigningKey); err != nil || len(pub) != ed25519.PublicKeySize {
+				return fmt.Errorf("invalid signing public key %q", key.SigningKey)
+			}
+
+			if err := c.APIKeys.Create(cmd.Context(), &key, secret); err != nil {
+				return err
+			}
+
+			output["key"] = key
+			return jsonPrint(cmd, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&key.Name, "name", "", "key name")
+	cmd.Flags().StringSliceVar(&key.Scopes, "scope", nil, fmt.Sprintf("key scopes, %v", core.Scopes))
+	cmd.Flags().StringSliceVar(&key.WalletIDs, "wallet", nil, "allowed wallet ids, all wallets if empty")
+	cmd.Flags().StringVar(&key.SigningKey, "signing-public-key", "", "hex ed25519 public key verifying signed requests, generated if empty")
+	return cmd
+}
+
+func (c *Cmd) listAPIKeysCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "list api keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keys, err := c.APIKeys.List(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			return jsonPrint(cmd, keys)
+		},
+	}
+}
+
+func (c *Cmd) revokeAPIKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "revoke an api key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return c.APIKeys.Revoke(cmd.Context(), id)
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package cmds
+
+import (
+	"fmt"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/spf13/cobra"
+)
+
+type auditBreak struct {
+	ID     uint64 `json:"id"`
+	Reason string `json:"reason"`
+}
+
+type auditReport struct {
+	Count  int          `json:"count"`
+	Head   string       `json:"head"`
+	Breaks []auditBreak `json:"breaks,omitempty"`
+}
+
+func (c *Cmd) verifyAuditLogCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-audit-log",
+		Short: "verify the hash chain of the audit log with the audit key",
+		Long: "verify the hash chain of the audit log. Deleting the latest entries can't be\n" +
+			"detected by the chain itself, compare the head hash with a previous report.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var (
+				report auditReport
+				query  = core.AuditQuery{Limit: 500}
+			)
+
+			for {
+				events, err := c.Audits.List(ctx, query)
+				if err != nil {
+					return err
+				}
+
+				if len(events) == 0 {
+					break
+				}
+
+				for _, event := range events {
+					if event.PrevHash != report.Head {
+						report.Breaks = append(report.Breaks, auditBreak{
+							ID:     event.ID,
+							Reason: "previous hash mismatch, entries deleted or altered before it",
+						})
+					}
+
+					if event.Digest(c.AuditKey) != event.Hash {
+						report.Breaks = append(report.Breaks, auditBreak{
+							ID:     event.ID,
+							Reason: "hash mismatch, entry altered",
+						})
+					}
+
+					report.Count++
+					report.Head = event.Hash
+					query.Offset = event.ID
+				}
+			}
+
+			if err := jsonPrint(cmd, report); err != nil {
+				return err
+			}
+
+			if len(report.Breaks) > 0 {
+				return fmt.Errorf("audit log broken at %d entries", len(report.Breaks))
+			}
+
+			return nil
+		},
+	}
+}
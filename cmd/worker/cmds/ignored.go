@@ -0,0 +1,72 @@
+package cmds
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/spf13/cobra"
+)
+
+func (c *Cmd) ignoredOutputsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ignored",
+		Short: "review the deposits ignored by the syncer's filters",
+	}
+
+	cmd.AddCommand(c.listIgnoredOutputsCmd())
+	cmd.AddCommand(c.releaseIgnoredOutputsCmd())
+	return cmd
+}
+
+func (c *Cmd) listIgnoredOutputsCmd() *cobra.Command {
+	var (
+		from  uint64
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list ignored deposits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputs, err := c.Outputs.ListIgnored(cmd.Context(), from, limit)
+			if err != nil {
+				return err
+			}
+
+			return jsonPrint(cmd, outputs)
+		},
+	}
+
+	cmd.Flags().Uint64Var(&from, "from", 0, "start sequence")
+	cmd.Flags().IntVar(&limit, "limit", 100, "max outputs listed")
+	return cmd
+}
+
+func (c *Cmd) releaseIgnoredOutputsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "release <sequence>...",
+		Short: "move ignored deposits to the outputs, they become spendable",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			for _, arg := range args {
+				sequence, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				if err := c.Outputs.Release(ctx, sequence); err != nil {
+					if store.IsErrNotFound(err) {
+						return fmt.Errorf("ignored output %d not found", sequence)
+					}
+
+					return err
+				}
+			}
+
+			return jsonPrint(cmd, map[string]int{"released": len(args)})
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package cmds
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pandodao/safe-wallet/worker/auditor"
+	"github.com/spf13/cobra"
+)
+
+func (c *Cmd) auditOutputsCmd() *cobra.Command {
+	var (
+		from       uint64
+		batchSize  int
+		quarantine bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "audit-outputs",
+		Short: "compare the stored outputs with the network and print the diffs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			report := &auditor.Report{From: from, Next: from, Diffs: []*auditor.Diff{}}
+			for {
+				r, err := c.Auditor.Audit(ctx, report.Next, batchSize, quarantine)
+				if err != nil {
+					return err
+				}
+
+				if r.Next == report.Next {
+					break
+				}
+
+				report.Next = r.Next
+				report.Checked += r.Checked
+				report.Quarantined += r.Quarantined
+				report.Diffs = append(report.Diffs, r.Diffs...)
+			}
+
+			if err := jsonPrint(cmd, report); err != nil {
+				return err
+			}
+
+			if len(report.Diffs) > 0 && !quarantine {
+				return fmt.Errorf("%d mismatches found, run with --quarantine to exclude the outputs", len(report.Diffs))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&from, "from", 0, "start sequence")
+	cmd.Flags().IntVar(&batchSize, "batch", 500, "outputs compared per network request")
+	cmd.Flags().BoolVar(&quarantine, "quarantine", false, "quarantine the mismatched outputs")
+	return cmd
+}
+
+func (c *Cmd) releaseOutputCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "release-output <sequence>",
+		Short: "release a quarantined output once it matches the network again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if err := c.Auditor.Release(cmd.Context(), sequence); err != nil {
+				return err
+			}
+
+			return jsonPrint(cmd, map[string]uint64{"released": sequence})
+		},
+	}
+}
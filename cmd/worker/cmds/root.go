@@ -3,15 +3,29 @@ package cmds
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 
-	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/worker/auditor"
+	"github.com/pandodao/safe-wallet/worker/syncer"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type Cmd struct {
-	Wallets core.WalletStore
+	Wallets    core.WalletStore
+	APIKeys    core.APIKeyStore
+	Audits     core.AuditStore
+	AuditKey   core.AuditKey
+	Auditor    *auditor.Auditor
+	Outputs    core.OutputStore
+	Outputz    core.OutputService
+	Properties core.PropertyStore
+	Transfers  core.TransferStore
+	Syncer     *syncer.Syncer
 }
 
 func (c *Cmd) Run(ctx context.Context, args []string) error {
@@ -20,13 +34,54 @@ func (c *Cmd) Run(ctx context.Context, args []string) error {
 		Short: "safe-wallet",
 	}
 
+	operator := os.Getenv("USER")
+	root.PersistentFlags().StringVar(&operator, "operator", operator, "operator name written to the audit log")
+
 	root.AddCommand(c.exportAllWalletsCmd())
 	root.AddCommand(c.exportWalletCmd())
+	root.AddCommand(c.apiKeyCmd())
+	root.AddCommand(c.verifyAuditLogCmd())
+	root.AddCommand(c.auditOutputsCmd())
+	root.AddCommand(c.releaseOutputCmd())
+	root.AddCommand(c.syncCmd())
+	root.AddCommand(c.ignoredOutputsCmd())
 
 	root.SetArgs(args)
 	root.SetOut(os.Stdout)
 
-	return root.ExecuteContext(ctx)
+	cmd, err := root.ExecuteContextC(ctx)
+	if cmd != nil && cmd.Runnable() {
+		if auditErr := c.audit(ctx, cmd, operator, err); auditErr != nil {
+			return errors.Join(err, fmt.Errorf("audit failed: %w", auditErr))
+		}
+	}
+
+	return err
+}
+
+// audit writes the executed command to the audit log
+func (c *Cmd) audit(ctx context.Context, cmd *cobra.Command, operator string, err error) error {
+	params := map[string]any{}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Name != "operator" {
+			params[f.Name] = f.Value.String()
+		}
+	})
+
+	b, _ := json.Marshal(params)
+	event := &core.AuditEvent{
+		Actor:   "cli:" + operator,
+		Action:  "cmd." + strings.ReplaceAll(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "), " ", "."),
+		Target:  strings.Join(cmd.Flags().Args(), " "),
+		Params:  string(b),
+		Outcome: core.AuditOutcomeOK,
+	}
+
+	if err != nil {
+		event.Outcome = "error: " + err.Error()
+	}
+
+	return c.Audits.Append(context.WithoutCancel(ctx), event)
 }
 
 func (c *Cmd) exportAllWalletsCmd() *cobra.Command {
@@ -35,12 +90,45 @@ func (c *Cmd) exportAllWalletsCmd() *cobra.Command {
 		Short: "export all subwallets",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
-			wallets, err := c.Wallets.List(ctx)
-			if err != nil {
-				return err
+			w := cmd.OutOrStdout()
+
+			// stream the keystores page by page instead of loading all wallets
+			fmt.Fprint(w, "[")
+
+			query := core.WalletQuery{Limit: 100}
+			for n := 0; ; {
+				wallets, err := c.Wallets.List(ctx, query)
+				if err != nil {
+					return err
+				}
+
+				if len(wallets) == 0 {
+					break
+				}
+
+				for _, wallet := range wallets {
+					query.Offset = wallet.ID
+
+					wallet, err := c.Wallets.Find(ctx, wallet.UserID)
+					if err != nil {
+						return err
+					}
+
+					b, err := json.MarshalIndent(keystoreFromWallet(wallet), "  ", "  ")
+					if err != nil {
+						return err
+					}
+
+					if n++; n > 1 {
+						fmt.Fprint(w, ",")
+					}
+
+					fmt.Fprintf(w, "\n  %s", b)
+				}
 			}
 
-			return jsonPrint(cmd, generic.MapSlice(wallets, keystoreFromWallet))
+			fmt.Fprintln(w, "\n]")
+			return nil
 		},
 	}
 }
@@ -0,0 +1,115 @@
+package cmds
+
+import (
+	"fmt"
+
+	"github.com/pandodao/safe-wallet/worker/syncer"
+	"github.com/spf13/cobra"
+)
+
+func (c *Cmd) syncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "inspect and repair the output syncer",
+	}
+
+	cmd.AddCommand(c.syncStatusCmd())
+	cmd.AddCommand(c.syncResetCmd())
+	cmd.AddCommand(c.syncBackfillCmd())
+	return cmd
+}
+
+func (c *Cmd) syncStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "show the sync offset and its lag behind the network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var offset uint64
+			if err := c.Properties.Get(ctx, syncer.PropertySyncOffset, &offset); err != nil {
+				return err
+			}
+
+			head, err := c.Outputz.Head(ctx)
+			if err != nil {
+				return err
+			}
+
+			var lag uint64
+			if head >= offset {
+				lag = head - offset + 1
+			}
+
+			return jsonPrint(cmd, map[string]uint64{
+				"offset": offset,
+				"head":   head,
+				"lag":    lag,
+			})
+		},
+	}
+}
+
+func (c *Cmd) syncResetCmd() *cobra.Command {
+	var offset uint64
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "move the sync offset, the syncer picks it up in its next round",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var previous uint64
+			version, err := c.Properties.GetVersioned(ctx, syncer.PropertySyncOffset, &previous)
+			if err != nil {
+				return err
+			}
+
+			// the syncer's cursor fails on the version change and reloads the offset
+			if _, err := c.Properties.CompareAndSet(ctx, syncer.PropertySyncOffset, version, offset); err != nil {
+				return err
+			}
+
+			return jsonPrint(cmd, map[string]uint64{
+				"previous": previous,
+				"offset":   offset,
+			})
+		},
+	}
+
+	cmd.Flags().Uint64Var(&offset, "offset", 0, "the next sequence to pull")
+	_ = cmd.MarkFlagRequired("offset")
+	return cmd
+}
+
+func (c *Cmd) syncBackfillCmd() *cobra.Command {
+	var from, to uint64
+
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "pull the outputs of a sequence range again through the deposit filters, the sync offset is left untouched",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if to < from {
+				return fmt.Errorf("--to %d is less than --from %d", to, from)
+			}
+
+			pulled, inserted, err := c.Syncer.Backfill(ctx, from, to)
+			if err != nil {
+				return err
+			}
+
+			return jsonPrint(cmd, map[string]int{
+				"pulled": pulled,
+				"added":  inserted,
+			})
+		},
+	}
+
+	cmd.Flags().Uint64Var(&from, "from", 0, "first sequence")
+	cmd.Flags().Uint64Var(&to, "to", 0, "last sequence")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
@@ -6,8 +6,11 @@ import (
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/google/wire"
+	"github.com/pandodao/safe-wallet/service/escrow"
 	"github.com/pandodao/safe-wallet/service/loader"
 	"github.com/pandodao/safe-wallet/service/output"
+	"github.com/pandodao/safe-wallet/service/sweep"
+	"github.com/pandodao/safe-wallet/service/wallet"
 	"github.com/spf13/viper"
 )
 
@@ -16,7 +19,11 @@ var serviceSet = wire.NewSet(
 	provideMixinClient,
 	provideSpendKey,
 	output.New,
+	wallet.New,
 	loader.New,
+	provideSweepConfig,
+	sweep.New,
+	escrow.New,
 )
 
 func provideKeystore(v *viper.Viper) *mixin.Keystore {
@@ -41,3 +48,16 @@ func provideSpendKey(v *viper.Viper, client *mixin.Client) (mixinnet.Key, error)
 	s := v.GetString("dapp.spend_key")
 	return mixinnet.ParseKeyWithPub(s, user.SpendPublicKey)
 }
+
+func provideSweepConfig(v *viper.Viper, ks *mixin.Keystore) (sweep.Config, error) {
+	cfg := sweep.Config{
+		ClientID:           ks.ClientID,
+		ArchiveDestination: v.GetString("sweep.archive_destination"),
+	}
+
+	if err := v.UnmarshalKey("sweep.rules", &cfg.Rules); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
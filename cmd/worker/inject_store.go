@@ -3,14 +3,26 @@ package main
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
 
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/google/wire"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/apikey"
+	"github.com/pandodao/safe-wallet/store/audit"
 	"github.com/pandodao/safe-wallet/store/db"
+	"github.com/pandodao/safe-wallet/store/escrow"
+	"github.com/pandodao/safe-wallet/store/invoice"
+	"github.com/pandodao/safe-wallet/store/lease"
+	"github.com/pandodao/safe-wallet/store/ledger"
+	"github.com/pandodao/safe-wallet/store/nonce"
 	"github.com/pandodao/safe-wallet/store/output"
 	"github.com/pandodao/safe-wallet/store/property"
+	"github.com/pandodao/safe-wallet/store/ratelimit"
+	"github.com/pandodao/safe-wallet/store/route"
+	"github.com/pandodao/safe-wallet/store/topup"
 	"github.com/pandodao/safe-wallet/store/transfer"
 	"github.com/pandodao/safe-wallet/store/wallet"
 	"github.com/spf13/viper"
@@ -22,8 +34,19 @@ var storeSet = wire.NewSet(
 	output.New,
 	transfer.New,
 	property.New,
+	lease.New,
 	provideEncryptKey,
+	provideAuditKey,
 	wallet.New,
+	topup.New,
+	apikey.New,
+	audit.New,
+	invoice.New,
+	route.New,
+	ledger.New,
+	escrow.New,
+	nonce.New,
+	ratelimit.New,
 )
 
 func provideEncryptKey(keystore *mixin.Keystore) ([]byte, error) {
@@ -46,6 +69,17 @@ func provideEncryptKey(keystore *mixin.Keystore) ([]byte, error) {
 	return key[:], nil
 }
 
+// provideAuditKey reads the key of the audit log digests, it must be the same
+// on all servers & workers and is never stored in the database
+func provideAuditKey(v *viper.Viper) (core.AuditKey, error) {
+	key := v.GetString("audit.key")
+	if key == "" {
+		return nil, errors.New("audit.key required")
+	}
+
+	return core.AuditKey(key), nil
+}
+
 func provideDB(v *viper.Viper) (*nap.DB, func(), error) {
 	v.SetDefault("db.driver", "mysql")
 
@@ -1,25 +1,171 @@
 package main
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/google/wire"
+	"github.com/pandodao/safe-wallet/worker/auditor"
 	"github.com/pandodao/safe-wallet/worker/cashier"
 	"github.com/pandodao/safe-wallet/worker/cleaner"
+	"github.com/pandodao/safe-wallet/worker/escrower"
+	"github.com/pandodao/safe-wallet/worker/invoicer"
+	"github.com/pandodao/safe-wallet/worker/leader"
+	"github.com/pandodao/safe-wallet/worker/pooler"
+	"github.com/pandodao/safe-wallet/worker/provisioner"
+	"github.com/pandodao/safe-wallet/worker/refiller"
+	"github.com/pandodao/safe-wallet/worker/releaser"
+	"github.com/pandodao/safe-wallet/worker/router"
+	"github.com/pandodao/safe-wallet/worker/sweeper"
 	"github.com/pandodao/safe-wallet/worker/syncer"
+	"github.com/shopspring/decimal"
 	"github.com/spf13/viper"
 )
 
 var workerSet = wire.NewSet(
+	provideLeaderConfig,
+	leader.New,
+	provideCashierConfig,
 	cashier.New,
+	provideSyncerConfig,
 	syncer.New,
 	provideCleanerConfig,
 	cleaner.New,
+	provideProvisionerConfig,
+	provisioner.New,
+	providePoolerConfig,
+	pooler.New,
+	sweeper.New,
+	provideRefillerConfig,
+	refiller.New,
+	provideAuditorConfig,
+	auditor.New,
+	invoicer.New,
+	router.New,
+	releaser.New,
+	escrower.New,
 )
 
+// workerID identifies the replica in leases and transfer claims, it must be unique among the replicas
+func workerID(v *viper.Viper) string {
+	if id := v.GetString("worker.id"); id != "" {
+		return id
+	}
+
+	hostname, _ := os.Hostname()
+	nonce := make([]byte, 4)
+	_, _ = rand.Read(nonce)
+
+	id := fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), hex.EncodeToString(nonce))
+	v.Set("worker.id", id)
+	return id
+}
+
+func provideLeaderConfig(v *viper.Viper) leader.Config {
+	v.SetDefault("leader.ttl", 15*time.Second)
+
+	return leader.Config{
+		Name:   "worker",
+		Holder: workerID(v),
+		TTL:    v.GetDuration("leader.ttl"),
+	}
+}
+
+func provideCashierConfig(v *viper.Viper) cashier.Config {
+	v.SetDefault("cashier.claim_ttl", time.Minute)
+
+	return cashier.Config{
+		Holder:   workerID(v),
+		ClaimTTL: v.GetDuration("cashier.claim_ttl"),
+	}
+}
+
+func provideSyncerConfig(v *viper.Viper) (syncer.Config, error) {
+	v.SetDefault("syncer.filter.unknown", syncer.UnknownAssetAccept)
+	v.SetDefault("syncer.filter.default_min_amount", "0")
+
+	filter := syncer.FilterConfig{
+		Allow:      v.GetStringSlice("syncer.filter.allow"),
+		Deny:       v.GetStringSlice("syncer.filter.deny"),
+		Unknown:    v.GetString("syncer.filter.unknown"),
+		MinAmounts: map[string]decimal.Decimal{},
+	}
+
+	if filter.Unknown != syncer.UnknownAssetAccept && filter.Unknown != syncer.UnknownAssetIgnore {
+		return syncer.Config{}, fmt.Errorf("invalid syncer.filter.unknown %q", filter.Unknown)
+	}
+
+	var err error
+	if filter.DefaultMinAmount, err = decimal.NewFromString(v.GetString("syncer.filter.default_min_amount")); err != nil {
+		return syncer.Config{}, fmt.Errorf("invalid syncer.filter.default_min_amount: %w", err)
+	}
+
+	for asset, amount := range v.GetStringMapString("syncer.filter.min_amounts") {
+		if filter.MinAmounts[asset], err = decimal.NewFromString(amount); err != nil {
+			return syncer.Config{}, fmt.Errorf("invalid min amount of %s: %w", asset, err)
+		}
+	}
+
+	return syncer.Config{Filter: filter}, nil
+}
+
 func provideCleanerConfig(v *viper.Viper, ks *mixin.Keystore) cleaner.Config {
 	v.SetDefault("cleaner.capacity", 512)
+	v.SetDefault("cleaner.spent_retention", 24*time.Hour)
+	v.SetDefault("cleaner.rate_bucket_retention", time.Hour)
+	v.SetDefault("cleaner.deposit_retention", 24*time.Hour)
 
 	return cleaner.Config{
-		Capacity: v.GetInt("cleaner.capacity"),
+		Capacity:            v.GetInt("cleaner.capacity"),
+		SpentRetention:      v.GetDuration("cleaner.spent_retention"),
+		RateBucketRetention: v.GetDuration("cleaner.rate_bucket_retention"),
+		DepositRetention:    v.GetDuration("cleaner.deposit_retention"),
+	}
+}
+
+func provideProvisionerConfig(v *viper.Viper) provisioner.Config {
+	v.SetDefault("provisioner.delay", time.Minute)
+	v.SetDefault("provisioner.report_after", time.Hour)
+
+	return provisioner.Config{
+		Delay:       v.GetDuration("provisioner.delay"),
+		ReportAfter: v.GetDuration("provisioner.report_after"),
+	}
+}
+
+func providePoolerConfig(v *viper.Viper) pooler.Config {
+	v.SetDefault("pooler.size", 10)
+	v.SetDefault("pooler.interval", 5*time.Second)
+
+	return pooler.Config{
+		Size:     v.GetInt("pooler.size"),
+		Interval: v.GetDuration("pooler.interval"),
+	}
+}
+
+func provideRefillerConfig(v *viper.Viper, ks *mixin.Keystore) refiller.Config {
+	v.SetDefault("refiller.cooldown", 10*time.Minute)
+	v.SetDefault("refiller.limit", 20)
+
+	return refiller.Config{
+		ClientID: ks.ClientID,
+		Cooldown: v.GetDuration("refiller.cooldown"),
+		Limit:    v.GetInt("refiller.limit"),
+	}
+}
+
+func provideAuditorConfig(v *viper.Viper) auditor.Config {
+	v.SetDefault("auditor.interval", time.Hour)
+	v.SetDefault("auditor.batch_size", 500)
+	v.SetDefault("auditor.missing_checks", 2)
+
+	return auditor.Config{
+		Interval:      v.GetDuration("auditor.interval"),
+		BatchSize:     v.GetInt("auditor.batch_size"),
+		MissingChecks: v.GetInt("auditor.missing_checks"),
 	}
 }
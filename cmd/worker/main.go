@@ -2,18 +2,30 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"fmt"
 	"log"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/carlmjohnson/versioninfo"
 	"github.com/pandodao/safe-wallet/cmd/worker/cmds"
+	"github.com/pandodao/safe-wallet/worker/auditor"
 	"github.com/pandodao/safe-wallet/worker/cashier"
 	"github.com/pandodao/safe-wallet/worker/cleaner"
+	"github.com/pandodao/safe-wallet/worker/escrower"
+	"github.com/pandodao/safe-wallet/worker/invoicer"
+	"github.com/pandodao/safe-wallet/worker/leader"
+	"github.com/pandodao/safe-wallet/worker/pooler"
+	"github.com/pandodao/safe-wallet/worker/provisioner"
+	"github.com/pandodao/safe-wallet/worker/refiller"
+	"github.com/pandodao/safe-wallet/worker/releaser"
+	"github.com/pandodao/safe-wallet/worker/router"
+	"github.com/pandodao/safe-wallet/worker/sweeper"
 	"github.com/pandodao/safe-wallet/worker/syncer"
 	"github.com/spf13/viper"
 	"golang.org/x/sync/errgroup"
@@ -21,9 +33,10 @@ import (
 
 var (
 	opt struct {
-		config  string
-		debug   bool
-		version bool
+		config      string
+		metricsAddr string
+		debug       bool
+		version     bool
 	}
 
 	version = "0.0.1-src"
@@ -32,6 +45,7 @@ var (
 
 func main() {
 	flag.StringVar(&opt.config, "config", "config.yaml", "config file path")
+	flag.StringVar(&opt.metricsAddr, "metrics-addr", "127.0.0.1:9091", "internal listener of /metrics, disabled if empty")
 	flag.BoolVar(&opt.debug, "debug", false, "debug mode")
 	flag.BoolVar(&opt.version, "version", false, "show version")
 	flag.Parse()
@@ -69,29 +83,107 @@ func main() {
 
 	var g errgroup.Group
 
-	g.Go(func() error {
-		return app.syncer.Run(ctx)
-	})
-
+	// the cashier runs on every replica, the transfers are claimed one by one
 	g.Go(func() error {
 		return app.cashier.Run(ctx)
 	})
 
+	// the other workers race on shared state, they only run on the elected leader
 	g.Go(func() error {
-		return app.cleaner.Run(ctx)
+		return app.leader.Run(ctx, func(ctx context.Context) error {
+			var g errgroup.Group
+
+			g.Go(func() error {
+				return app.syncer.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.cleaner.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.provisioner.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.pooler.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.sweeper.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.refiller.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.auditor.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.invoicer.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.router.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.releaser.Run(ctx)
+			})
+
+			g.Go(func() error {
+				return app.escrower.Run(ctx)
+			})
+
+			return g.Wait()
+		})
 	})
 
+	if opt.metricsAddr != "" {
+		metrics := newMetricsServer(opt.metricsAddr)
+
+		g.Go(metrics.ListenAndServe)
+		g.Go(func() error {
+			<-ctx.Done()
+			return metrics.Shutdown(context.Background())
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		logger.Error("worker exit", "err", err)
 	}
 }
 
+// newMetricsServer serves the expvar metrics of the workers, like the refiller's
+// alerts, on the internal listener
+func newMetricsServer(addr string) *http.Server {
+	m := http.NewServeMux()
+	m.Handle("/metrics", expvar.Handler())
+
+	return &http.Server{
+		Addr:    addr,
+		Handler: m,
+	}
+}
+
 type app struct {
-	cmds    *cmds.Cmd
-	syncer  *syncer.Syncer
-	cashier *cashier.Cashier
-	cleaner *cleaner.Cleaner
-	logger  *slog.Logger
+	cmds        *cmds.Cmd
+	leader      *leader.Elector
+	syncer      *syncer.Syncer
+	cashier     *cashier.Cashier
+	cleaner     *cleaner.Cleaner
+	provisioner *provisioner.Provisioner
+	pooler      *pooler.Pooler
+	sweeper     *sweeper.Sweeper
+	refiller    *refiller.Refiller
+	auditor     *auditor.Auditor
+	invoicer    *invoicer.Invoicer
+	router      *router.Router
+	releaser    *releaser.Releaser
+	escrower    *escrower.Escrower
+	logger      *slog.Logger
 }
 
 func initLogger() *slog.Logger {
@@ -8,14 +8,37 @@ package main
 
 import (
 	"github.com/pandodao/safe-wallet/cmd/worker/cmds"
+	escrow2 "github.com/pandodao/safe-wallet/service/escrow"
 	"github.com/pandodao/safe-wallet/service/loader"
-	"github.com/pandodao/safe-wallet/service/output"
-	output2 "github.com/pandodao/safe-wallet/store/output"
+	output2 "github.com/pandodao/safe-wallet/service/output"
+	"github.com/pandodao/safe-wallet/service/sweep"
+	wallet2 "github.com/pandodao/safe-wallet/service/wallet"
+	"github.com/pandodao/safe-wallet/store/apikey"
+	"github.com/pandodao/safe-wallet/store/audit"
+	"github.com/pandodao/safe-wallet/store/escrow"
+	"github.com/pandodao/safe-wallet/store/invoice"
+	"github.com/pandodao/safe-wallet/store/lease"
+	"github.com/pandodao/safe-wallet/store/ledger"
+	"github.com/pandodao/safe-wallet/store/nonce"
+	"github.com/pandodao/safe-wallet/store/output"
 	"github.com/pandodao/safe-wallet/store/property"
+	"github.com/pandodao/safe-wallet/store/ratelimit"
+	"github.com/pandodao/safe-wallet/store/route"
+	"github.com/pandodao/safe-wallet/store/topup"
 	"github.com/pandodao/safe-wallet/store/transfer"
 	"github.com/pandodao/safe-wallet/store/wallet"
+	"github.com/pandodao/safe-wallet/worker/auditor"
 	"github.com/pandodao/safe-wallet/worker/cashier"
 	"github.com/pandodao/safe-wallet/worker/cleaner"
+	"github.com/pandodao/safe-wallet/worker/escrower"
+	"github.com/pandodao/safe-wallet/worker/invoicer"
+	"github.com/pandodao/safe-wallet/worker/leader"
+	"github.com/pandodao/safe-wallet/worker/pooler"
+	"github.com/pandodao/safe-wallet/worker/provisioner"
+	"github.com/pandodao/safe-wallet/worker/refiller"
+	"github.com/pandodao/safe-wallet/worker/releaser"
+	"github.com/pandodao/safe-wallet/worker/router"
+	"github.com/pandodao/safe-wallet/worker/sweeper"
 	"github.com/pandodao/safe-wallet/worker/syncer"
 	"github.com/spf13/viper"
 	"log/slog"
@@ -35,34 +58,101 @@ func setupApp(v *viper.Viper, logger *slog.Logger) (app, func(), error) {
 		return app{}, nil, err
 	}
 	walletStore := wallet.New(db, v2)
-	cmd := &cmds.Cmd{
-		Wallets: walletStore,
+	apiKeyStore := apikey.New(db)
+	auditKey, err := provideAuditKey(v)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
 	}
+	auditStore := audit.New(db, auditKey)
+	outputStore := output.New(db)
 	client, err := provideMixinClient(keystore)
 	if err != nil {
 		cleanup()
 		return app{}, nil, err
 	}
-	outputService := output.New(client)
-	outputStore := output2.New(db)
+	outputService := output2.New(client)
+	config := provideAuditorConfig(v)
+	auditorAuditor := auditor.New(outputStore, outputService, logger, config)
 	propertyStore := property.New(db)
-	syncerSyncer := syncer.New(outputService, outputStore, propertyStore, logger)
 	transferStore := transfer.New(db)
+	syncerConfig, err := provideSyncerConfig(v)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	syncerSyncer := syncer.New(outputService, outputStore, transferStore, propertyStore, logger, syncerConfig)
+	cmd := &cmds.Cmd{
+		Wallets:    walletStore,
+		APIKeys:    apiKeyStore,
+		Audits:     auditStore,
+		AuditKey:   auditKey,
+		Auditor:    auditorAuditor,
+		Outputs:    outputStore,
+		Outputz:    outputService,
+		Properties: propertyStore,
+		Transfers:  transferStore,
+		Syncer:     syncerSyncer,
+	}
+	leaseStore := lease.New(db)
+	leaderConfig := provideLeaderConfig(v)
+	elector := leader.New(leaseStore, logger, leaderConfig)
 	key, err := provideSpendKey(v, client)
 	if err != nil {
 		cleanup()
 		return app{}, nil, err
 	}
 	serviceLoader := loader.New(walletStore, client, key)
-	cashierCashier := cashier.New(outputStore, transferStore, serviceLoader, logger)
-	config := provideCleanerConfig(v, keystore)
-	cleanerCleaner := cleaner.New(outputStore, transferStore, outputService, logger, config)
+	cashierConfig := provideCashierConfig(v)
+	cashierCashier := cashier.New(outputStore, transferStore, serviceLoader, logger, cashierConfig)
+	nonceStore := nonce.New(db)
+	rateBucketStore := ratelimit.New(db)
+	cleanerConfig := provideCleanerConfig(v, keystore)
+	cleanerCleaner := cleaner.New(outputStore, transferStore, walletStore, nonceStore, rateBucketStore, logger, cleanerConfig)
+	walletService := wallet2.New(client, walletStore)
+	provisionerConfig := provideProvisionerConfig(v)
+	provisionerProvisioner := provisioner.New(walletStore, walletService, logger, provisionerConfig)
+	poolerConfig := providePoolerConfig(v)
+	poolerPooler := pooler.New(walletStore, walletService, logger, poolerConfig)
+	ledgerStore := ledger.New(db)
+	sweepConfig, err := provideSweepConfig(v, keystore)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	sweepService, err := sweep.New(outputStore, transferStore, walletStore, walletService, ledgerStore, sweepConfig)
+	if err != nil {
+		cleanup()
+		return app{}, nil, err
+	}
+	sweeperSweeper := sweeper.New(sweepService, logger)
+	topupStore := topup.New(db)
+	refillerConfig := provideRefillerConfig(v, keystore)
+	refillerRefiller := refiller.New(outputStore, transferStore, walletStore, topupStore, sweepService, logger, refillerConfig)
+	invoiceStore := invoice.New(db)
+	invoicerInvoicer := invoicer.New(outputStore, invoiceStore, propertyStore, logger)
+	routeStore := route.New(db)
+	routerRouter := router.New(outputStore, routeStore, ledgerStore, propertyStore, logger)
+	releaserReleaser := releaser.New(transferStore, logger)
+	escrowStore := escrow.New(db)
+	escrowService := escrow2.New(outputStore, transferStore, escrowStore)
+	escrowerEscrower := escrower.New(escrowStore, escrowService, logger)
 	mainApp := app{
-		cmds:    cmd,
-		syncer:  syncerSyncer,
-		cashier: cashierCashier,
-		cleaner: cleanerCleaner,
-		logger:  logger,
+		cmds:        cmd,
+		leader:      elector,
+		syncer:      syncerSyncer,
+		cashier:     cashierCashier,
+		cleaner:     cleanerCleaner,
+		provisioner: provisionerProvisioner,
+		pooler:      poolerPooler,
+		sweeper:     sweeperSweeper,
+		refiller:    refillerRefiller,
+		auditor:     auditorAuditor,
+		invoicer:    invoicerInvoicer,
+		router:      routerRouter,
+		releaser:    releaserReleaser,
+		escrower:    escrowerEscrower,
+		logger:      logger,
 	}
 	return mainApp, func() {
 		cleanup()
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	ScopeWalletCreate    = "wallet:create"
+	ScopeWalletRead      = "wallet:read"
+	ScopeTransferCreate  = "transfer:create"
+	ScopeTransferRead    = "transfer:read"
+	ScopeOutputRead      = "output:read"
+	ScopeInvoiceCreate   = "invoice:create"
+	ScopeInvoiceRead     = "invoice:read"
+	ScopeAccountTransfer = "account:transfer"
+	ScopeAccountRead     = "account:read"
+	ScopeEscrowCreate    = "escrow:create"
+	ScopeEscrowRead      = "escrow:read"
+	ScopeRouteWrite      = "route:write"
+	ScopeRouteRead       = "route:read"
+	// ScopeEscrowSettle is required by arbiters to release or refund escrows
+	ScopeEscrowSettle = "escrow:settle"
+	// ScopeAdmin grants every other scope
+	ScopeAdmin = "admin"
+)
+
+var Scopes = []string{
+	ScopeWalletCreate,
+	ScopeWalletRead,
+	ScopeTransferCreate,
+	ScopeTransferRead,
+	ScopeOutputRead,
+	ScopeInvoiceCreate,
+	ScopeInvoiceRead,
+	ScopeAccountTransfer,
+	ScopeAccountRead,
+	ScopeEscrowCreate,
+	ScopeEscrowRead,
+	ScopeEscrowSettle,
+	ScopeRouteWrite,
+	ScopeRouteRead,
+	ScopeAdmin,
+}
+
+// APIKey is an api identity, either a stored key or a client certificate
+// identity which has no ID
+type APIKey struct {
+	ID        uint64    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	// WalletIDs limits the key to these wallets, empty for all wallets
+	WalletIDs []string  `json:"wallet_ids,omitempty"`
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+	// Hash is the HashSecret of the secret
+	Hash string `json:"-"`
+	// SigningKey is the hex encoded ed25519 public key verifying signed requests,
+	// the private key is kept by the client only. Empty if the key can't sign.
+	SigningKey string `json:"signing_key,omitempty"`
+}
+
+func HashSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// Principal identifies the key in audit logs & rate limits
+func (k *APIKey) Principal() string {
+	if k.ID == 0 {
+		return "cert:" + k.Name
+	}
+
+	return "apikey:" + strconv.FormatUint(k.ID, 10)
+}
+
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, ScopeAdmin) || slices.Contains(k.Scopes, scope)
+}
+
+func (k *APIKey) AllowWallet(userID string) bool {
+	return len(k.WalletIDs) == 0 || slices.Contains(k.WalletIDs, userID)
+}
+
+type APIKeyStore interface {
+	// Create saves the key with the hash of secret & its public signing key, the
+	// secret itself is never stored
+	Create(ctx context.Context, key *APIKey, secret string) error
+	// FindSecret finds the unrevoked key of the secret
+	FindSecret(ctx context.Context, secret string) (*APIKey, error)
+	// Find finds the unrevoked key of the id
+	Find(ctx context.Context, id uint64) (*APIKey, error)
+	List(ctx context.Context) ([]*APIKey, error)
+	Revoke(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const AuditOutcomeOK = "ok"
+
+// AuditKey keys the digests of the audit log. It's kept out of the database, so
+// whoever can write the table still can't rebuild a valid chain.
+type AuditKey []byte
+
+// AuditEvent is an entry of the append-only audit log. Every entry is chained
+// to the previous one by PrevHash, so deleted or altered entries break the chain.
+type AuditEvent struct {
+	ID        uint64    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// Actor is apikey:<id> for rpc calls or cli:<operator> for commands
+	Actor  string `json:"actor"`
+	Action string `json:"action"`
+	Target string `json:"target,omitempty"`
+	// Params is the json of the request parameters, with secrets redacted
+	Params  string `json:"params,omitempty"`
+	Outcome string `json:"outcome"`
+
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// Digest is the HMAC-SHA256 of the event with its previous hash
+func (e *AuditEvent) Digest(key AuditKey) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(strings.Join([]string{
+		e.PrevHash,
+		strconv.FormatInt(e.CreatedAt.Unix(), 10),
+		e.Actor,
+		e.Action,
+		e.Target,
+		e.Params,
+		e.Outcome,
+	}, "\n")))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type AuditQuery struct {
+	// Offset is the id of the last event of the previous page
+	Offset uint64
+	Limit  int
+	Actor  string
+	Action string
+}
+
+type AuditStore interface {
+	// Append chains the event to the last one and saves it, CreatedAt, PrevHash & Hash are set
+	Append(ctx context.Context, event *AuditEvent) error
+	List(ctx context.Context, query AuditQuery) ([]*AuditEvent, error)
+}
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAuditEventDigest(t *testing.T) {
+	event := &AuditEvent{
+		CreatedAt: time.Unix(1700000000, 0),
+		Actor:     "apikey:1",
+		Action:    "rpc.CreateTransfer",
+		Target:    "trace",
+		Params:    `{"amount":"1"}`,
+		Outcome:   AuditOutcomeOK,
+	}
+
+	digest := event.Digest(AuditKey("key"))
+	if digest != event.Digest(AuditKey("key")) {
+		t.Fatal("digest not stable")
+	}
+
+	if digest == event.Digest(AuditKey("other")) {
+		t.Fatal("digest not keyed")
+	}
+
+	event.Outcome = "internal: failed"
+	if digest == event.Digest(AuditKey("key")) {
+		t.Fatal("altered event has the same digest")
+	}
+}
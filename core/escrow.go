@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrEscrowUnfunded is returned by settling an escrow whose wallet hasn't received the funds yet
+var ErrEscrowUnfunded = errors.New("escrow wallet not funded yet")
+
+type EscrowStatus uint8
+
+const (
+	_ EscrowStatus = iota
+	EscrowStatusLocked
+	// EscrowStatusReleasing & EscrowStatusRefunding escrows are decided, their transfers are being created
+	EscrowStatusReleasing
+	EscrowStatusRefunding
+	EscrowStatusReleased
+	EscrowStatusRefunded
+)
+
+//go:generate enumer -type=EscrowStatus -trimprefix=EscrowStatus -json
+
+// Escrow locks funds of the payer in a dedicated wallet, they are released to
+// the payee by the arbiter or refunded to the payer after the deadline
+type Escrow struct {
+	ID        uint64    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	TraceID   string    `json:"trace_id"`
+	PayerID   string    `json:"payer_id"`
+	// WalletID is the escrow wallet holding the funds
+	WalletID string            `json:"wallet_id"`
+	AssetID  string            `json:"asset_id"`
+	Amount   decimal.Decimal   `json:"amount"`
+	Memo     string            `json:"memo,omitempty"`
+	Payee    *mixin.MixAddress `json:"payee"`
+	// ArbiterKeyID is the api key allowed to release the escrow
+	ArbiterKeyID uint64       `json:"arbiter_key_id"`
+	Deadline     time.Time    `json:"deadline"`
+	Status       EscrowStatus `json:"status"`
+	// SettledBy is the principal deciding the release or refund
+	SettledBy string    `json:"settled_by,omitempty"`
+	SettledAt time.Time `json:"settled_at,omitempty"`
+}
+
+func escrowTrace(action, traceID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("escrow %s %s", action, traceID))).String()
+}
+
+// FundTransfer moves the funds from the payer to the escrow wallet
+func (e *Escrow) FundTransfer() *Transfer {
+	return &Transfer{
+		TraceID:  escrowTrace("fund", e.TraceID),
+		Status:   TransferStatusPending,
+		UserID:   e.PayerID,
+		AssetID:  e.AssetID,
+		Amount:   e.Amount,
+		Memo:     e.Memo,
+		Opponent: mixin.RequireNewMixAddress([]string{e.WalletID}, 1),
+	}
+}
+
+// SettleTransfer is the transfer out of the escrow wallet for the decided
+// escrow, nil if it is still locked. The traces are derived from the escrow,
+// so an escrow is settled once.
+func (e *Escrow) SettleTransfer() *Transfer {
+	t := &Transfer{
+		Status:  TransferStatusPending,
+		UserID:  e.WalletID,
+		AssetID: e.AssetID,
+		Amount:  e.Amount,
+		Memo:    e.Memo,
+	}
+
+	switch e.Status {
+	case EscrowStatusReleasing, EscrowStatusReleased:
+		t.TraceID = escrowTrace("release", e.TraceID)
+		t.Opponent = e.Payee
+	case EscrowStatusRefunding, EscrowStatusRefunded:
+		t.TraceID = escrowTrace("refund", e.TraceID)
+		t.Opponent = mixin.RequireNewMixAddress([]string{e.PayerID}, 1)
+		t.Internal = true
+	default:
+		return nil
+	}
+
+	return t
+}
+
+type EscrowStore interface {
+	Create(ctx context.Context, escrow *Escrow) error
+	FindTrace(ctx context.Context, traceID string) (*Escrow, error)
+	// UpdateStatus moves the escrow from its current status, with optimistic lock on the status
+	UpdateStatus(ctx context.Context, escrow *Escrow, to EscrowStatus, actor string) error
+	ListStatus(ctx context.Context, status EscrowStatus, limit int) ([]*Escrow, error)
+	// ListExpired lists the locked escrows whose deadline passed before the time
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*Escrow, error)
+}
+
+type EscrowService interface {
+	// Settle assigns the settle transfer of a releasing or refunding escrow and
+	// marks it released or refunded, ErrEscrowUnfunded if the funds haven't arrived
+	Settle(ctx context.Context, escrow *Escrow) (*Transfer, error)
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/shopspring/decimal"
+)
+
+func TestEscrowSettleTransfer(t *testing.T) {
+	const (
+		payer  = "9e8a6a1b-58a0-4b9d-b5f4-44b4b1b5ce3c"
+		wallet = "2bb9a2c4-7f1a-4a6e-9d46-6e2d9c7c9b10"
+		payee  = "5e6a4f3c-1b2d-4c8e-9f0a-7b3c2d1e0f9a"
+	)
+
+	escrow := &Escrow{
+		TraceID:  "0d5c9f34-7b1a-4e3c-8f2d-6a9b1c0e4d7f",
+		PayerID:  payer,
+		WalletID: wallet,
+		AssetID:  "c6d0c728-2624-429b-8e0d-d9d19b6592fa",
+		Amount:   decimal.RequireFromString("0.5"),
+		Payee:    mixin.RequireNewMixAddress([]string{payee}, 1),
+	}
+
+	testCases := []struct {
+		status   EscrowStatus
+		opponent string
+		internal bool
+	}{
+		{EscrowStatusLocked, "", false},
+		{EscrowStatusReleasing, payee, false},
+		{EscrowStatusReleased, payee, false},
+		{EscrowStatusRefunding, payer, true},
+		{EscrowStatusRefunded, payer, true},
+	}
+
+	traces := map[EscrowStatus]string{}
+	for _, tc := range testCases {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			escrow.Status = tc.status
+			transfer := escrow.SettleTransfer()
+			if tc.opponent == "" {
+				if transfer != nil {
+					t.Fatalf("settle transfer of %s escrow = %s, want nil", tc.status, transfer.TraceID)
+				}
+
+				return
+			}
+
+			if transfer.UserID != wallet {
+				t.Errorf("user id = %s, want %s", transfer.UserID, wallet)
+			}
+
+			if members := transfer.Opponent.Members(); len(members) != 1 || members[0] != tc.opponent {
+				t.Errorf("opponents = %v, want %s", members, tc.opponent)
+			}
+
+			if transfer.Internal != tc.internal {
+				t.Errorf("internal = %v, want %v", transfer.Internal, tc.internal)
+			}
+
+			traces[tc.status] = transfer.TraceID
+		})
+	}
+
+	if traces[EscrowStatusReleasing] != traces[EscrowStatusReleased] || traces[EscrowStatusRefunding] != traces[EscrowStatusRefunded] {
+		t.Error("settle trace changed after settled")
+	}
+
+	if traces[EscrowStatusReleasing] == traces[EscrowStatusRefunding] {
+		t.Error("release & refund share the trace")
+	}
+
+	if fund := escrow.FundTransfer(); fund.TraceID == escrow.TraceID || fund.UserID != payer {
+		t.Errorf("fund transfer = %s from %s", fund.TraceID, fund.UserID)
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type InvoiceStatus uint8
+
+const (
+	_ InvoiceStatus = iota
+	InvoiceStatusUnpaid
+	InvoiceStatusPartiallyPaid
+	InvoiceStatusPaid
+	InvoiceStatusOverpaid
+	InvoiceStatusExpired
+)
+
+//go:generate enumer -type=InvoiceStatus -trimprefix=InvoiceStatus -json
+
+// Invoice is a payment request to a wallet, deposits carrying its memo pay it
+type Invoice struct {
+	ID        uint64          `json:"id,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	TraceID   string          `json:"trace_id"`
+	UserID    string          `json:"user_id"`
+	AssetID   string          `json:"asset_id"`
+	Amount    decimal.Decimal `json:"amount"`
+	// Memo is unique in the wallet, it defaults to the trace id
+	Memo      string          `json:"memo"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Status    InvoiceStatus   `json:"status"`
+	Paid      decimal.Decimal `json:"paid"`
+	PaidAt    time.Time       `json:"paid_at"`
+}
+
+// Open reports whether the invoice still waits for payments
+func (i *Invoice) Open() bool {
+	return i.Status == InvoiceStatusUnpaid || i.Status == InvoiceStatusPartiallyPaid
+}
+
+// Receive adds the payment made at the time to the invoice. Payments made
+// after expiry are counted so they can be refunded, but the invoice stays
+// expired. Payments made before expiry count even if the invoice was expired
+// before they were matched.
+func (i *Invoice) Receive(amount decimal.Decimal, at time.Time) {
+	i.Paid = i.Paid.Add(amount)
+	i.PaidAt = at
+
+	if at.After(i.ExpiresAt) && (i.Open() || i.Status == InvoiceStatusExpired) {
+		i.Status = InvoiceStatusExpired
+		return
+	}
+
+	switch i.Paid.Cmp(i.Amount) {
+	case -1:
+		i.Status = InvoiceStatusPartiallyPaid
+	case 0:
+		i.Status = InvoiceStatusPaid
+	default:
+		i.Status = InvoiceStatusOverpaid
+	}
+}
+
+// PaymentURI is the Mixin pay link of the unpaid amount. Once the invoice is
+// partially paid, the trace is derived from the paid amount so the link isn't
+// one the network already used.
+func (i *Invoice) PaymentURI() string {
+	addr := mixin.RequireNewMixAddress([]string{i.UserID}, 1)
+
+	amount, trace := i.Amount, i.TraceID
+	if i.Paid.IsPositive() && i.Paid.LessThan(i.Amount) {
+		amount = i.Amount.Sub(i.Paid)
+		trace = uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("invoice %s %s", i.TraceID, i.Paid))).String()
+	}
+
+	q := url.Values{}
+	q.Set("asset", i.AssetID)
+	q.Set("amount", amount.String())
+	q.Set("memo", i.Memo)
+	q.Set("trace", trace)
+
+	return fmt.Sprintf("https://mixin.one/pay/%s?%s", addr, q.Encode())
+}
+
+type InvoiceQuery struct {
+	// Offset is the id of the last invoice of the previous page
+	Offset uint64
+	Limit  int
+	UserID string
+	Status InvoiceStatus
+}
+
+type InvoiceStore interface {
+	Create(ctx context.Context, invoice *Invoice) error
+	FindTrace(ctx context.Context, traceID string) (*Invoice, error)
+	FindMemo(ctx context.Context, userID, memo string) (*Invoice, error)
+	List(ctx context.Context, query InvoiceQuery) ([]*Invoice, error)
+	// Pay credits the output to the invoice, an output is credited only once
+	Pay(ctx context.Context, invoice *Invoice, output *Output) error
+	// Expire expires the open invoices expired before the time
+	Expire(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestInvoiceReceive(t *testing.T) {
+	expiresAt := time.Now()
+	before, after := expiresAt.Add(-time.Minute), expiresAt.Add(time.Minute)
+
+	testCases := []struct {
+		name     string
+		status   InvoiceStatus
+		paidAt   time.Time
+		payments []string
+		paid     string
+		want     InvoiceStatus
+	}{
+		{"partially paid", InvoiceStatusUnpaid, before, []string{"0.4"}, "0.4", InvoiceStatusPartiallyPaid},
+		{"paid", InvoiceStatusUnpaid, before, []string{"0.4", "0.6"}, "1", InvoiceStatusPaid},
+		{"overpaid", InvoiceStatusUnpaid, before, []string{"0.4", "0.7"}, "1.1", InvoiceStatusOverpaid},
+		{"paid after expired", InvoiceStatusExpired, after, []string{"1"}, "1", InvoiceStatusExpired},
+		{"paid after expiry, not expired yet", InvoiceStatusUnpaid, after, []string{"1"}, "1", InvoiceStatusExpired},
+		{"paid before expiry, matched after", InvoiceStatusExpired, before, []string{"1"}, "1", InvoiceStatusPaid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			invoice := &Invoice{Amount: decimal.NewFromInt(1), ExpiresAt: expiresAt, Status: tc.status}
+			for _, p := range tc.payments {
+				invoice.Receive(decimal.RequireFromString(p), tc.paidAt)
+			}
+
+			if !invoice.Paid.Equal(decimal.RequireFromString(tc.paid)) {
+				t.Errorf("paid = %s, want %s", invoice.Paid, tc.paid)
+			}
+
+			if invoice.Status != tc.want {
+				t.Errorf("status = %s, want %s", invoice.Status, tc.want)
+			}
+		})
+	}
+}
+
+func TestInvoicePaymentURI(t *testing.T) {
+	invoice := &Invoice{
+		TraceID: "1c3b5a2e-4a4c-4a3f-9a55-6a3a3c1ea4f2",
+		UserID:  "6a3a3c1e-4a4c-4a3f-9a55-1c3b5a2ea4f2",
+		AssetID: "4d8c508b-91c5-375b-92b0-ee702ed2dac5",
+		Amount:  decimal.NewFromInt(1),
+		Status:  InvoiceStatusUnpaid,
+	}
+
+	query := func() url.Values {
+		u, err := url.Parse(invoice.PaymentURI())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return u.Query()
+	}
+
+	first := query()
+	if first.Get("trace") != invoice.TraceID || first.Get("amount") != "1" {
+		t.Fatalf("expected the invoice trace & amount, got %s", first.Encode())
+	}
+
+	invoice.Receive(decimal.RequireFromString("0.4"), invoice.ExpiresAt)
+	second := query()
+	if second.Get("trace") == invoice.TraceID || second.Get("amount") != "0.6" {
+		t.Fatalf("expected a new trace for the rest, got %s", second.Encode())
+	}
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrLeaseHeld is returned by Acquire if the lease is held by another holder
+	ErrLeaseHeld = errors.New("lease held by another holder")
+	// ErrLeaseLost is returned by fenced writes once the lease expired or changed hands
+	ErrLeaseLost = errors.New("lease lost")
+)
+
+// Lease is a named lock held by one worker process until it expires.
+// Token is the fencing token, it increases every time the lease changes hands.
+type Lease struct {
+	Name      string
+	Holder    string
+	Token     uint64
+	ExpiresAt time.Time
+}
+
+type LeaseStore interface {
+	// Acquire takes the lease or renews it if the holder already owns it
+	Acquire(ctx context.Context, name, holder string, ttl time.Duration) (*Lease, error)
+	Release(ctx context.Context, lease *Lease) error
+}
+
+type leaseKey struct{}
+
+// WithLease marks the context as run under the lease, writes of the stores are
+// fenced by it so a stale holder can't commit once the lease changed hands
+func WithLease(ctx context.Context, lease *Lease) context.Context {
+	return context.WithValue(ctx, leaseKey{}, lease)
+}
+
+// LeaseFrom returns the lease the context runs under, nil if it is not run by a leader
+func LeaseFrom(ctx context.Context) *Lease {
+	lease, _ := ctx.Value(leaseKey{}).(*Lease)
+	return lease
+}
@@ -0,0 +1,222 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInsufficientAccountBalance is returned if a posting overdraws a virtual account
+	ErrInsufficientAccountBalance = errors.New("insufficient account balance")
+	// ErrLedgerExceedsBalance is returned if the virtual balances would exceed the wallet's balance
+	ErrLedgerExceedsBalance = errors.New("virtual balances exceed wallet balance")
+)
+
+// AccountChain is the on-chain side of a wallet in the ledger. Its balance is
+// minus the sum of the wallet's virtual balances, so every posting sums to zero.
+const AccountChain = "@chain"
+
+// ValidateAccount checks the name of a virtual account, names starting with @ are reserved
+func ValidateAccount(name string) error {
+	if name == "" || len(name) > 64 {
+		return fmt.Errorf("account name must be 1 to 64 bytes")
+	}
+
+	if strings.HasPrefix(name, "@") {
+		return fmt.Errorf("account name %q is reserved", name)
+	}
+
+	return nil
+}
+
+type PostingKind uint8
+
+const (
+	_ PostingKind = iota
+	PostingKindDeposit
+	PostingKindTransfer
+	PostingKindWithdraw
+	PostingKindReversal
+)
+
+//go:generate enumer -type=PostingKind -trimprefix=PostingKind -json
+
+// LedgerEntry moves Amount of the asset in or out of a virtual account,
+// the entries of a posting share the trace id
+type LedgerEntry struct {
+	ID        uint64          `json:"id,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	TraceID   string          `json:"trace_id"`
+	Kind      PostingKind     `json:"kind"`
+	UserID    string          `json:"user_id"`
+	Account   string          `json:"account"`
+	AssetID   string          `json:"asset_id"`
+	Amount    decimal.Decimal `json:"amount"`
+	Memo      string          `json:"memo,omitempty"`
+}
+
+// AccountBalance is the balance of a virtual account in the asset
+type AccountBalance struct {
+	UserID    string          `json:"user_id"`
+	Account   string          `json:"account"`
+	AssetID   string          `json:"asset_id"`
+	Amount    decimal.Decimal `json:"amount"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type PostingEntry struct {
+	Account string
+	Amount  decimal.Decimal
+}
+
+// Posting is a double-entry change of a wallet's virtual balances in one asset
+type Posting struct {
+	TraceID string
+	Kind    PostingKind
+	UserID  string
+	AssetID string
+	Memo    string
+	Entries []PostingEntry
+}
+
+// NewDepositPosting credits an on-chain deposit to the account
+func NewDepositPosting(traceID, userID, assetID, account string, amount decimal.Decimal, memo string) *Posting {
+	return &Posting{
+		TraceID: traceID,
+		Kind:    PostingKindDeposit,
+		UserID:  userID,
+		AssetID: assetID,
+		Memo:    memo,
+		Entries: []PostingEntry{
+			{Account: AccountChain, Amount: amount.Neg()},
+			{Account: account, Amount: amount},
+		},
+	}
+}
+
+// NewTransferPosting moves the amount between two accounts of the wallet off-chain
+func NewTransferPosting(traceID, userID, assetID, from, to string, amount decimal.Decimal, memo string) *Posting {
+	return &Posting{
+		TraceID: traceID,
+		Kind:    PostingKindTransfer,
+		UserID:  userID,
+		AssetID: assetID,
+		Memo:    memo,
+		Entries: []PostingEntry{
+			{Account: from, Amount: amount.Neg()},
+			{Account: to, Amount: amount},
+		},
+	}
+}
+
+// NewWithdrawPosting debits the account for an on-chain transfer with the same trace id
+func NewWithdrawPosting(traceID, userID, assetID, account string, amount decimal.Decimal, memo string) *Posting {
+	return &Posting{
+		TraceID: traceID,
+		Kind:    PostingKindWithdraw,
+		UserID:  userID,
+		AssetID: assetID,
+		Memo:    memo,
+		Entries: []PostingEntry{
+			{Account: account, Amount: amount.Neg()},
+			{Account: AccountChain, Amount: amount},
+		},
+	}
+}
+
+// Reverse returns the posting undoing p
+func (p *Posting) Reverse(traceID string) *Posting {
+	r := &Posting{
+		TraceID: traceID,
+		Kind:    PostingKindReversal,
+		UserID:  p.UserID,
+		AssetID: p.AssetID,
+		Memo:    "reverse " + p.TraceID,
+	}
+
+	for _, e := range p.Entries {
+		r.Entries = append(r.Entries, PostingEntry{Account: e.Account, Amount: e.Amount.Neg()})
+	}
+
+	return r
+}
+
+// Same reports whether the entries posted with the trace id are the posting's,
+// the same accounts of the wallet moved by the same amounts of the asset
+func (p *Posting) Same(entries []*LedgerEntry) bool {
+	if len(entries) != len(p.Entries) {
+		return false
+	}
+
+	amounts := make(map[string]decimal.Decimal, len(p.Entries))
+	for _, e := range p.Entries {
+		amounts[e.Account] = e.Amount
+	}
+
+	for _, e := range entries {
+		amount, ok := amounts[e.Account]
+		if !ok || e.Kind != p.Kind || e.UserID != p.UserID || e.AssetID != p.AssetID || !e.Amount.Equal(amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate checks the posting is balanced and touches each account once
+func (p *Posting) Validate() error {
+	if len(p.Entries) < 2 {
+		return fmt.Errorf("posting needs at least two entries")
+	}
+
+	var (
+		sum      decimal.Decimal
+		accounts = map[string]bool{}
+	)
+
+	for _, e := range p.Entries {
+		if e.Account == "" || e.Amount.IsZero() {
+			return fmt.Errorf("invalid entry of account %q", e.Account)
+		}
+
+		if accounts[e.Account] {
+			return fmt.Errorf("account %q posted twice", e.Account)
+		}
+
+		accounts[e.Account] = true
+		sum = sum.Add(e.Amount)
+	}
+
+	if !sum.IsZero() {
+		return fmt.Errorf("posting not balanced, sum %s", sum)
+	}
+
+	return nil
+}
+
+type LedgerQuery struct {
+	// Offset is the id of the last entry of the previous page
+	Offset  uint64
+	Limit   int
+	UserID  string
+	Account string
+	AssetID string
+}
+
+type LedgerStore interface {
+	// Post applies the posting in one transaction. Virtual accounts can't go
+	// negative and, except for reversals, the virtual balances of the wallet
+	// can't exceed the on-chain balance backing them. It returns false if the
+	// trace was posted already.
+	Post(ctx context.Context, posting *Posting) (bool, error)
+	// FindPosting lists the entries of the trace
+	FindPosting(ctx context.Context, traceID string) ([]*LedgerEntry, error)
+	ListEntries(ctx context.Context, query LedgerQuery) ([]*LedgerEntry, error)
+	// ListBalances lists the virtual balances of the wallet, of all its accounts if account is empty
+	ListBalances(ctx context.Context, userID, account string) ([]*AccountBalance, error)
+}
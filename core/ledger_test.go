@@ -0,0 +1,75 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPostingValidate(t *testing.T) {
+	amount := decimal.RequireFromString("1.5")
+
+	testCases := []struct {
+		name    string
+		posting *Posting
+		valid   bool
+	}{
+		{"deposit", NewDepositPosting("t", "u", "a", "alice", amount, ""), true},
+		{"transfer", NewTransferPosting("t", "u", "a", "alice", "bob", amount, ""), true},
+		{"withdraw", NewWithdrawPosting("t", "u", "a", "alice", amount, ""), true},
+		{"reversal", NewWithdrawPosting("t", "u", "a", "alice", amount, "").Reverse("r"), true},
+		{"same account", NewTransferPosting("t", "u", "a", "alice", "alice", amount, ""), false},
+		{"zero amount", NewTransferPosting("t", "u", "a", "alice", "bob", decimal.Zero, ""), false},
+		{"unbalanced", &Posting{Entries: []PostingEntry{{"alice", amount}, {"bob", amount}}}, false},
+		{"single entry", &Posting{Entries: []PostingEntry{{"alice", amount}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.posting.Validate(); (err == nil) != tc.valid {
+				t.Errorf("Validate() = %v, want valid %v", err, tc.valid)
+			}
+		})
+	}
+}
+
+func TestPostingSame(t *testing.T) {
+	amount := decimal.RequireFromString("1.5")
+	posted := NewWithdrawPosting("t", "u", "a", "alice", amount, "")
+
+	var entries []*LedgerEntry
+	for _, e := range posted.Entries {
+		entries = append(entries, &LedgerEntry{
+			TraceID: posted.TraceID,
+			Kind:    posted.Kind,
+			UserID:  posted.UserID,
+			Account: e.Account,
+			AssetID: posted.AssetID,
+			Amount:  e.Amount,
+		})
+	}
+
+	testCases := []struct {
+		name    string
+		posting *Posting
+		same    bool
+	}{
+		{"retry", NewWithdrawPosting("t", "u", "a", "alice", decimal.RequireFromString("1.50"), "other memo"), true},
+		{"other account", NewWithdrawPosting("t", "u", "a", "bob", amount, ""), false},
+		{"other amount", NewWithdrawPosting("t", "u", "a", "alice", decimal.NewFromInt(2), ""), false},
+		{"other asset", NewWithdrawPosting("t", "u", "b", "alice", amount, ""), false},
+		{"other kind", NewDepositPosting("t", "u", "a", "alice", amount.Neg(), ""), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.posting.Same(entries); got != tc.same {
+				t.Errorf("Same() = %v, want %v", got, tc.same)
+			}
+		})
+	}
+
+	if NewWithdrawPosting("t", "u", "a", "alice", amount, "").Same(entries[:1]) {
+		t.Error("expected a partial posting to differ")
+	}
+}
@@ -0,0 +1,15 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// NonceStore remembers the nonces of signed requests, it's shared by all server
+// replicas so a request can't be replayed against another one
+type NonceStore interface {
+	// Use saves the nonce of the key until expires, it reports false if the nonce was used before
+	Use(ctx context.Context, keyID uint64, nonce string, expires time.Time) (bool, error)
+	// DeleteExpired deletes at most limit nonces expired before now
+	DeleteExpired(ctx context.Context, limit int) (int64, error)
+}
@@ -2,12 +2,31 @@ package core
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/shopspring/decimal"
 )
 
+// ErrOutputQuarantined is returned by ListRange if an output of the range is
+// quarantined, the transfer can't be sent until the output is released
+var ErrOutputQuarantined = errors.New("output quarantined")
+
+type OutputState uint8
+
+const (
+	_ OutputState = iota
+	OutputStateUnspent
+	OutputStateSigned
+	OutputStateSpent
+	// OutputStateQuarantined is set by the auditor if the output doesn't match
+	// the network, it is never spent or counted in balances
+	OutputStateQuarantined
+)
+
+//go:generate enumer -type=OutputState -trimprefix=OutputState -json
+
 type Output struct {
 	Sequence  uint64          `json:"sequence,omitempty"`
 	CreatedAt time.Time       `json:"created_at"`
@@ -16,6 +35,37 @@ type Output struct {
 	UserID    string          `json:"user_id,omitempty"`
 	AssetID   string          `json:"asset_id,omitempty"`
 	Amount    decimal.Decimal `json:"amount"`
+	// State only moves forward, unspent -> signed -> spent, or to quarantined
+	State OutputState `json:"state"`
+	// SpentBy is the hash of the transaction spending the output, set once signed
+	SpentBy string    `json:"spent_by,omitempty"`
+	SpentAt time.Time `json:"spent_at,omitempty"`
+
+	Senders          []string `json:"senders,omitempty"`
+	SendersThreshold uint8    `json:"senders_threshold,omitempty"`
+	// Extra is the raw extra of the transaction, hex encoded
+	Extra string `json:"extra,omitempty"`
+	// Memo is the extra decoded as text, empty if it isn't
+	Memo            string `json:"memo,omitempty"`
+	InscriptionHash string `json:"inscription_hash,omitempty"`
+}
+
+type OutputQuery struct {
+	// Offset is the sequence of the last output of the previous page
+	Offset  uint64
+	Limit   int
+	UserID  string
+	AssetID string
+	State   OutputState
+	// Deposit excludes the outputs of our own transactions, like changes
+	Deposit bool
+}
+
+// IgnoredOutput is a deposit dropped by the syncer's filters, it can be released to the outputs
+type IgnoredOutput struct {
+	Output
+	Reason    string    `json:"reason"`
+	IgnoredAt time.Time `json:"ignored_at"`
 }
 
 type Balance struct {
@@ -26,15 +76,48 @@ type Balance struct {
 }
 
 type OutputStore interface {
-	Save(ctx context.Context, outputs []*Output) error
+	// Save inserts the outputs or moves the state of the saved ones forward,
+	// outputs are matched by hash and index. New outputs are saved after the
+	// stored ones, the sequences of the outputs are set to the stored ones.
+	// It returns the number of inserted outputs.
+	Save(ctx context.Context, outputs []*Output) (int, error)
+	Find(ctx context.Context, sequence uint64) (*Output, error)
 	List(ctx context.Context, userID string, offset uint64, limit int) ([]*Output, error)
+	Query(ctx context.Context, query OutputQuery) ([]*Output, error)
+	// ListTarget lists unspent outputs after offset until their sum reaches target
 	ListTarget(ctx context.Context, userID, assetID string, offset uint64, target decimal.Decimal, limit int) ([]*Output, error)
+	// ListRange lists the outputs in the range, they are assigned to a transfer.
+	// Spent outputs are listed to rebuild the transaction, it fails with
+	// ErrOutputQuarantined if any output of the range is quarantined.
 	ListRange(ctx context.Context, userID, assetID string, from, to uint64) ([]*Output, error)
 	Delete(ctx context.Context, sequence uint64) error
+	Quarantine(ctx context.Context, sequences ...uint64) error
+	// Unquarantine restores the quarantined output to the state, it fails if the output isn't quarantined
+	Unquarantine(ctx context.Context, sequence uint64, state OutputState) error
+	// DeleteSpent deletes at most limit outputs spent before the time
+	DeleteSpent(ctx context.Context, before time.Time, limit int) (int64, error)
+	// Ignore saves the filtered outputs aside, they are not spendable until released
+	Ignore(ctx context.Context, outputs []*IgnoredOutput) error
+	ListIgnored(ctx context.Context, offset uint64, limit int) ([]*IgnoredOutput, error)
+	// Release moves the ignored output to the outputs, after the stored ones
+	Release(ctx context.Context, sequence uint64) error
+	// SaveDeposits keeps the deposits apart from the outputs, refunds still
+	// find their senders after the outputs are merged or deleted
+	SaveDeposits(ctx context.Context, outputs []*Output) error
+	FindDeposit(ctx context.Context, sequence uint64) (*Output, error)
+	// DeleteDeposits deletes at most limit deposits saved before the time
+	DeleteDeposits(ctx context.Context, before time.Time, limit int) (int64, error)
+	// SumBalances sums the unspent and unassigned outputs
 	SumBalances(ctx context.Context, userID, assetID string) ([]*Balance, error)
 }
 
 type OutputService interface {
+	// Pull lists the outputs changed after offset, an output shows up again
+	// with a new sequence once it is signed or spent
 	Pull(ctx context.Context, offset uint64, limit int) ([]*Output, uint64, error)
 	ListRange(ctx context.Context, assetID string, from, to uint64) ([]*Output, error)
+	// Head returns the latest output sequence on the network
+	Head(ctx context.Context) (uint64, error)
+	// Find reads the output by its transaction hash and index, nil if the network doesn't know it
+	Find(ctx context.Context, hash mixinnet.Hash, index uint8) (*Output, error)
 }
@@ -1,8 +1,34 @@
 package core
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrVersionConflict is returned by CompareAndSet if the property was written by someone else
+var ErrVersionConflict = errors.New("property version conflict")
+
+type Property struct {
+	Key   string
+	Value json.RawMessage
+	// Version is bumped by every write, the first write sets it to 1
+	Version   uint64
+	UpdatedAt time.Time
+}
 
 type PropertyStore interface {
 	Get(ctx context.Context, key string, value any) error
+	// Set upserts the property regardless of its version
 	Set(ctx context.Context, key string, value any) error
+	// GetVersioned loads the property into value and returns its version, 0 if it is not set
+	GetVersioned(ctx context.Context, key string, value any) (uint64, error)
+	// CompareAndSet writes the property only if its version is still version, 0 expects
+	// the property not set. It returns the new version. The write is fenced by the
+	// lease of the context, if any.
+	CompareAndSet(ctx context.Context, key string, version uint64, value any) (uint64, error)
+	Delete(ctx context.Context, key string) error
+	// List lists the properties whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]*Property, error)
 }
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// RateLimit is a token bucket refilled by Rate tokens per second up to Burst
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Take refills the bucket with the tokens of the elapsed time and takes one.
+// It returns the tokens left, or the wait until the next token if it's empty.
+func (l RateLimit) Take(tokens float64, elapsed time.Duration) (float64, time.Duration, bool) {
+	tokens = math.Min(tokens+elapsed.Seconds()*l.Rate, float64(l.Burst))
+	if tokens >= 1 {
+		return tokens - 1, 0, true
+	}
+
+	if l.Rate <= 0 {
+		return tokens, time.Duration(math.MaxInt64), false
+	}
+
+	wait := time.Duration((1 - tokens) / l.Rate * float64(time.Second))
+	return tokens, wait, false
+}
+
+type RateLimiter interface {
+	// Take takes a token from the bucket of the key, a new bucket is full.
+	// It reports the wait until the next token if the bucket is empty.
+	Take(ctx context.Context, key string, limit RateLimit) (time.Duration, bool, error)
+}
+
+// RateBucketStore is the rate limiter sharing its buckets in the database
+type RateBucketStore interface {
+	RateLimiter
+	// DeleteIdle deletes at most limit buckets not taken since before, a deleted
+	// bucket starts full again, so it's only safe once the bucket is refilled
+	DeleteIdle(ctx context.Context, before time.Time, limit int) (int64, error)
+}
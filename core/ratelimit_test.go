@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimitTake(t *testing.T) {
+	limit := RateLimit{Rate: 2, Burst: 3}
+
+	tokens, _, ok := limit.Take(3, 0)
+	if !ok || tokens != 2 {
+		t.Fatalf("take from a full bucket: tokens %v, ok %v", tokens, ok)
+	}
+
+	tokens, wait, ok := limit.Take(0.5, 0)
+	if ok || wait != 250*time.Millisecond {
+		t.Fatalf("take from an empty bucket: wait %v, ok %v", wait, ok)
+	}
+
+	// refilled by the elapsed time, but never above the burst
+	tokens, _, ok = limit.Take(tokens, time.Hour)
+	if !ok || tokens != 2 {
+		t.Fatalf("take after refill: tokens %v, ok %v", tokens, ok)
+	}
+
+	if _, wait, ok := (RateLimit{Burst: 1}).Take(0, time.Hour); ok || wait <= 0 {
+		t.Fatalf("take without rate: wait %v, ok %v", wait, ok)
+	}
+}
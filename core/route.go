@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RouteRule credits the deposits of a wallet matching all its conditions to
+// a virtual account. Empty conditions match any deposit.
+type RouteRule struct {
+	ID        uint64    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UserID    string    `json:"user_id"`
+	// Priority orders the rules of a wallet, the lowest matching rule wins
+	Priority   int             `json:"priority"`
+	MemoPrefix string          `json:"memo_prefix,omitempty"`
+	MemoRegex  string          `json:"memo_regex,omitempty"`
+	Sender     string          `json:"sender,omitempty"`
+	AssetID    string          `json:"asset_id,omitempty"`
+	MinAmount  decimal.Decimal `json:"min_amount"`
+	// MaxAmount is inclusive, zero means no limit
+	MaxAmount decimal.Decimal `json:"max_amount"`
+	Account   string          `json:"account"`
+
+	memoRegex *regexp.Regexp
+}
+
+// Compile parses the memo regex once, Match parses it on every call otherwise
+func (r *RouteRule) Compile() error {
+	if r.MemoRegex == "" {
+		return nil
+	}
+
+	re, err := regexp.Compile(r.MemoRegex)
+	if err != nil {
+		return err
+	}
+
+	r.memoRegex = re
+	return nil
+}
+
+// Match reports whether the deposit satisfies every condition of the rule
+func (r *RouteRule) Match(output *Output) bool {
+	if output.UserID != r.UserID {
+		return false
+	}
+
+	if r.AssetID != "" && output.AssetID != r.AssetID {
+		return false
+	}
+
+	if r.Sender != "" && !slices.Contains(output.Senders, r.Sender) {
+		return false
+	}
+
+	if output.Amount.LessThan(r.MinAmount) {
+		return false
+	}
+
+	if r.MaxAmount.IsPositive() && output.Amount.GreaterThan(r.MaxAmount) {
+		return false
+	}
+
+	if r.MemoPrefix != "" && !strings.HasPrefix(output.Memo, r.MemoPrefix) {
+		return false
+	}
+
+	if r.MemoRegex != "" {
+		re := r.memoRegex
+		if re == nil {
+			var err error
+			if re, err = regexp.Compile(r.MemoRegex); err != nil {
+				return false
+			}
+		}
+
+		if !re.MatchString(output.Memo) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DepositRoute is the virtual account a deposit is credited to. Deposits that
+// match no rule, of wallets without rules too, are kept with an empty account
+// until they are assigned manually.
+type DepositRoute struct {
+	OutputSequence uint64          `json:"output_sequence"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UserID         string          `json:"user_id"`
+	AssetID        string          `json:"asset_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Memo           string          `json:"memo,omitempty"`
+	Account        string          `json:"account,omitempty"`
+	// RuleID is the matched rule, zero if unmatched or assigned manually
+	RuleID uint64 `json:"rule_id,omitempty"`
+	// Operator assigned the deposit manually
+	Operator string `json:"operator,omitempty"`
+}
+
+// Posting credits the deposit to its account, the trace is derived from the output
+func (r *DepositRoute) Posting() *Posting {
+	trace := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("deposit %d", r.OutputSequence)))
+	return NewDepositPosting(trace.String(), r.UserID, r.AssetID, r.Account, r.Amount, fmt.Sprintf("deposit %d", r.OutputSequence))
+}
+
+type DepositRouteQuery struct {
+	// Offset is the output sequence of the last route of the previous page
+	Offset    uint64
+	Limit     int
+	UserID    string
+	Account   string
+	Unmatched bool
+}
+
+type RouteStore interface {
+	// SaveRule creates the rule, or updates it if the id is set
+	SaveRule(ctx context.Context, rule *RouteRule) error
+	FindRule(ctx context.Context, id uint64) (*RouteRule, error)
+	DeleteRule(ctx context.Context, id uint64) error
+	// ListRules lists the rules ordered by priority, all wallets' if userID is empty
+	ListRules(ctx context.Context, userID string) ([]*RouteRule, error)
+	// Route saves the routes, a deposit is routed only once
+	Route(ctx context.Context, routes []*DepositRoute) error
+	FindRoute(ctx context.Context, sequence uint64) (*DepositRoute, error)
+	ListRoutes(ctx context.Context, query DepositRouteQuery) ([]*DepositRoute, error)
+	// Assign credits an unmatched deposit to the account
+	Assign(ctx context.Context, route *DepositRoute, account, operator string) error
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRouteRuleMatch(t *testing.T) {
+	const (
+		user   = "9e8a6a1b-58a0-4b9d-b5f4-44b4b1b5ce3c"
+		btc    = "c6d0c728-2624-429b-8e0d-d9d19b6592fa"
+		xin    = "c94ac88f-4671-3976-b60a-09064f1811e8"
+		sender = "2bb9a2c4-7f1a-4a6e-9d46-6e2d9c7c9b10"
+	)
+
+	output := &Output{
+		UserID:  user,
+		AssetID: btc,
+		Amount:  decimal.RequireFromString("0.5"),
+		Memo:    "acct:1024",
+		Senders: []string{sender},
+	}
+
+	testCases := []struct {
+		name string
+		rule RouteRule
+		want bool
+	}{
+		{"any", RouteRule{UserID: user}, true},
+		{"other wallet", RouteRule{UserID: sender}, false},
+		{"memo prefix", RouteRule{UserID: user, MemoPrefix: "acct:"}, true},
+		{"memo prefix mismatch", RouteRule{UserID: user, MemoPrefix: "order:"}, false},
+		{"memo regex", RouteRule{UserID: user, MemoRegex: `^acct:\d+$`}, true},
+		{"memo regex mismatch", RouteRule{UserID: user, MemoRegex: `^acct:\d{5}$`}, false},
+		{"sender", RouteRule{UserID: user, Sender: sender}, true},
+		{"sender mismatch", RouteRule{UserID: user, Sender: user}, false},
+		{"asset mismatch", RouteRule{UserID: user, AssetID: xin}, false},
+		{"amount range", RouteRule{UserID: user, MinAmount: decimal.RequireFromString("0.5"), MaxAmount: decimal.NewFromInt(1)}, true},
+		{"below min", RouteRule{UserID: user, MinAmount: decimal.NewFromInt(1)}, false},
+		{"above max", RouteRule{UserID: user, MaxAmount: decimal.RequireFromString("0.1")}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.rule.Match(output); got != tc.want {
+				t.Errorf("Match() = %v, want %v", got, tc.want)
+			}
+
+			if err := tc.rule.Compile(); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := tc.rule.Match(output); got != tc.want {
+				t.Errorf("compiled Match() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/shopspring/decimal"
+)
+
+// ErrSweepNotAllowed is returned by sweeping the master wallet, an escrow wallet
+// or a wallet that isn't active
+var ErrSweepNotAllowed = errors.New("wallet can not be swept")
+
+// ArchiveSweepMemo is the memo of the sweeps of archived wallets, the only
+// transfers sent for them
+const ArchiveSweepMemo = "archive sweep"
+
+// SweepRule moves the balance of an asset out of sub-wallets once it reaches MinAmount
+type SweepRule struct {
+	AssetID     string            `json:"asset_id"`
+	MinAmount   decimal.Decimal   `json:"min_amount"`
+	Destination *mixin.MixAddress `json:"destination"`
+	Interval    time.Duration     `json:"interval"`
+}
+
+type SweepService interface {
+	Rules() []*SweepRule
+	// SweepWallet assigns sweep transfers for the balances of the wallet matched
+	// by the rules, assetID limits the sweep to one rule if not empty. Funds
+	// allocated to virtual accounts are left in the wallet.
+	SweepWallet(ctx context.Context, userID, assetID string) ([]*Transfer, error)
+	// SweepAll sweeps all active sub-wallets, it returns the assigned sweeps
+	// together with the joined errors of the balances failing to sweep
+	SweepAll(ctx context.Context, assetID string) ([]*Transfer, error)
+	// ArchiveDestination is where archived wallets are swept to, nil if not set
+	ArchiveDestination() *mixin.MixAddress
+	// SweepArchived sweeps the deposits made to archived wallets after they
+	// were archived to the archive destination
+	SweepArchived(ctx context.Context) ([]*Transfer, error)
+}
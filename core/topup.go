@@ -0,0 +1,39 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TopupRule refills a wallet from the master wallet up to Target once its
+// balance of the asset drops below Floor
+type TopupRule struct {
+	ID         uint64          `json:"id,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UserID     string          `json:"user_id"`
+	AssetID    string          `json:"asset_id"`
+	Floor      decimal.Decimal `json:"floor"`
+	Target     decimal.Decimal `json:"target"`
+	Refills    uint64          `json:"refills"`
+	RefilledAt time.Time       `json:"refilled_at"`
+}
+
+// ConflictsWith reports whether a top-up by the rule would be swept out again by
+// the sweep rule. Refills go up to the target, so it must stay below the sweep
+// threshold, or the funds ping-pong between the master wallet and the wallet.
+func (r *TopupRule) ConflictsWith(sweep *SweepRule) bool {
+	return r.AssetID == sweep.AssetID && r.Target.GreaterThanOrEqual(sweep.MinAmount)
+}
+
+type TopupStore interface {
+	// Save creates the rule or updates the floor & target of the existing one
+	Save(ctx context.Context, rule *TopupRule) error
+	Delete(ctx context.Context, userID, assetID string) error
+	List(ctx context.Context, userID string) ([]*TopupRule, error)
+	// ListDue lists the rules not refilled within the cooldown
+	ListDue(ctx context.Context, cooldown time.Duration) ([]*TopupRule, error)
+	// Refilled counts a refill of the rule, with optimistic lock on Refills
+	Refilled(ctx context.Context, rule *TopupRule) error
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTopupRuleConflictsWith(t *testing.T) {
+	const (
+		btc = "c6d0c728-2624-429b-8e0d-d9d19b6592fa"
+		xin = "c94ac88f-4671-3976-b60a-09064f1811e8"
+	)
+
+	sweep := &SweepRule{AssetID: btc, MinAmount: decimal.NewFromInt(10)}
+
+	testCases := []struct {
+		name string
+		rule TopupRule
+		want bool
+	}{
+		{"below threshold", TopupRule{AssetID: btc, Floor: decimal.NewFromInt(2), Target: decimal.NewFromInt(5)}, false},
+		{"target at threshold", TopupRule{AssetID: btc, Floor: decimal.NewFromInt(2), Target: decimal.NewFromInt(10)}, true},
+		{"floor above threshold", TopupRule{AssetID: btc, Floor: decimal.NewFromInt(12), Target: decimal.NewFromInt(20)}, true},
+		{"other asset", TopupRule{AssetID: xin, Floor: decimal.NewFromInt(12), Target: decimal.NewFromInt(20)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.rule.ConflictsWith(sweep); got != tc.want {
+				t.Errorf("ConflictsWith = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
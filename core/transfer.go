@@ -2,12 +2,19 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
+// ErrTransferNotHeld is returned by Capture & Release if the transfer was
+// captured, released or its hold expired meanwhile
+var ErrTransferNotHeld = errors.New("transfer not held")
+
 type TransferStatus uint8
 
 const (
@@ -15,6 +22,10 @@ const (
 	TransferStatusPending
 	TransferStatusAssigned
 	TransferStatusHandled
+	// TransferStatusHeld transfers have outputs assigned, they wait to be captured or released
+	TransferStatusHeld
+	// TransferStatusReleased holds are never sent, their outputs are merged back to the wallet
+	TransferStatusReleased
 )
 
 //go:generate enumer -type=TransferStatus -trimprefix=TransferStatus -json
@@ -30,15 +41,82 @@ type Transfer struct {
 	Memo        string            `json:"memo,omitempty"`
 	Opponent    *mixin.MixAddress `json:"opponent,omitempty"`
 	AssignRange [2]uint64         `json:"assign_range,omitempty"`
+	// Internal is set if the opponent is the master wallet or one of its wallets
+	Internal bool `json:"internal,omitempty"`
+	// TxHash is the hash of the spending transaction, set once handled
+	TxHash string `json:"tx_hash,omitempty"`
+	// OutputSequence is the receiver's output of an internal transfer, set once synced
+	OutputSequence uint64 `json:"output_sequence,omitempty"`
+	// RefundOf is the sequence of the deposit the transfer refunds
+	RefundOf uint64 `json:"refund_of,omitempty"`
+	// HoldExpires is when a held transfer is released if not captured
+	HoldExpires time.Time `json:"hold_expires,omitempty"`
+}
+
+// ToSelf reports whether the transfer sends the funds back to its own wallet, like merges
+func (t *Transfer) ToSelf() bool {
+	members := t.Opponent.Members()
+	return t.Opponent.Threshold == 1 && len(members) == 1 && members[0] == t.UserID
+}
+
+// ReleaseTransfer is the internal transfer merging the outputs of the held transfer back to its wallet
+func (t *Transfer) ReleaseTransfer() *Transfer {
+	memo := fmt.Sprintf("release %s", t.TraceID)
+
+	return &Transfer{
+		TraceID:     uuid.NewSHA1(uuid.NameSpaceOID, []byte(memo)).String(),
+		Status:      TransferStatusAssigned,
+		UserID:      t.UserID,
+		AssetID:     t.AssetID,
+		Amount:      t.Amount,
+		Memo:        memo,
+		Opponent:    mixin.RequireNewMixAddress([]string{t.UserID}, 1),
+		AssignRange: t.AssignRange,
+		Internal:    true,
+	}
+}
+
+type TransferQuery struct {
+	// Offset is the id of the last transfer of the previous page
+	Offset   uint64
+	Limit    int
+	UserID   string
+	AssetID  string
+	Internal *bool
 }
 
 type TransferStore interface {
 	Create(ctx context.Context, transfer *Transfer) error
+	// Assign assigns the outputs after offset to the transfer, it fails with
+	// ErrLedgerExceedsBalance if the transfer spends the funds of the wallet's
+	// virtual accounts
 	Assign(ctx context.Context, transfer *Transfer, offset uint64) error
 	UpdateStatus(ctx context.Context, transfer *Transfer, to TransferStatus) error
 	FindTrace(ctx context.Context, traceID string) (*Transfer, error)
+	// ListStatus lists transfers of the status, skipping frozen wallets, archived
+	// wallets but for their archive sweeps, and claimed transfers
 	ListStatus(ctx context.Context, status TransferStatus, limit int) ([]*Transfer, error)
 	GetAssignOffset(ctx context.Context, userID, assetID string) (uint64, error)
+	// CountInflight counts the transfers of the wallet not handled, released or captured yet
+	CountInflight(ctx context.Context, userID string) (int, error)
+	List(ctx context.Context, query TransferQuery) ([]*Transfer, error)
+	// Claim reserves the assigned transfer for the holder until ttl passes,
+	// it reports false if another holder claimed it first
+	Claim(ctx context.Context, transfer *Transfer, holder string, ttl time.Duration) (bool, error)
+	// ListTxHashes returns the hashes spent by transfers among hashes
+	ListTxHashes(ctx context.Context, hashes []string) ([]string, error)
+	// LinkOutputs links internal transfers to the receivers' outputs
+	LinkOutputs(ctx context.Context, outputs []*Output) error
+	// Capture assigns the held transfer for the amount, the rest of its outputs
+	// goes back as change. It fails with ErrTransferNotHeld once the hold expired,
+	// and with ErrLedgerExceedsBalance if the virtual balances aren't backed.
+	Capture(ctx context.Context, transfer *Transfer, amount decimal.Decimal) error
+	// Release marks the held transfer released and creates its release transfer
+	// in one transaction, it fails with ErrTransferNotHeld if it's not held anymore
+	Release(ctx context.Context, transfer *Transfer) (*Transfer, error)
+	// ListExpiredHolds lists the held transfers expired by the database clock,
+	// the one Capture checks the expiry by
+	ListExpiredHolds(ctx context.Context, limit int) ([]*Transfer, error)
 }
 
 type TransferService interface {
@@ -7,9 +7,9 @@ import (
 	"fmt"
 )
 
-const _TransferStatusName = "PendingAssignedHandled"
+const _TransferStatusName = "PendingAssignedHandledHeldReleased"
 
-var _TransferStatusIndex = [...]uint8{0, 7, 15, 22}
+var _TransferStatusIndex = [...]uint8{0, 7, 15, 22, 26, 34}
 
 func (i TransferStatus) String() string {
 	i -= 1
@@ -19,12 +19,14 @@ func (i TransferStatus) String() string {
 	return _TransferStatusName[_TransferStatusIndex[i]:_TransferStatusIndex[i+1]]
 }
 
-var _TransferStatusValues = []TransferStatus{1, 2, 3}
+var _TransferStatusValues = []TransferStatus{1, 2, 3, 4, 5}
 
 var _TransferStatusNameToValueMap = map[string]TransferStatus{
 	_TransferStatusName[0:7]:   1,
 	_TransferStatusName[7:15]:  2,
 	_TransferStatusName[15:22]: 3,
+	_TransferStatusName[22:26]: 4,
+	_TransferStatusName[26:34]: 5,
 }
 
 // TransferStatusString retrieves an enum value from the enum constants string name.
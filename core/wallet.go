@@ -1,25 +1,118 @@
 package core
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+)
+
+// ErrWalletReserved is returned by reserving a wallet request being created by another call
+var ErrWalletReserved = errors.New("wallet request in progress")
+
+type WalletStatus uint8
+
+const (
+	_ WalletStatus = iota
+	WalletStatusProvisioning
+	WalletStatusActive
+	WalletStatusFrozen
+	WalletStatusArchived
+)
+
+//go:generate enumer -type=WalletStatus -trimprefix=WalletStatus -json
 
 type Wallet struct {
-	UserID     string `json:"user_id"`
-	Label      string `json:"label"`
-	SessionID  string `json:"session_id"`
-	PrivateKey string `json:"private_key"`
-	PinToken   string `json:"pin_token"`
-	Pin        string `json:"pin"`
-	SpendKey   string `json:"spend_key"`
+	ID         uint64          `json:"id,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UserID     string          `json:"user_id"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Status     WalletStatus    `json:"status"`
+	Label      string          `json:"label"`
+	ExternalID string          `json:"external_id,omitempty"`
+	Metadata   json.RawMessage `json:"metadata,omitempty"`
+	SessionID  string          `json:"session_id"`
+	PrivateKey string          `json:"private_key"`
+	PinToken   string          `json:"pin_token"`
+	Pin        string          `json:"pin"`
+	SpendKey   string          `json:"spend_key"`
+}
+
+// WalletEvent records a status change of a wallet
+type WalletEvent struct {
+	ID        uint64       `json:"id,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	UserID    string       `json:"user_id"`
+	From      WalletStatus `json:"from"`
+	To        WalletStatus `json:"to"`
+	Actor     string       `json:"actor"`
+	Reason    string       `json:"reason,omitempty"`
+}
+
+type WalletQuery struct {
+	// Offset lists wallets with id greater than it
+	Offset     uint64
+	Limit      int
+	Status     WalletStatus
+	Label      string
+	ExternalID string
+	// Metadata lists wallets whose metadata contains this json document
+	Metadata json.RawMessage
+	// ExcludePool skips the unclaimed wallets of the wallet pool
+	ExcludePool bool
+}
+
+type WalletPoolStats struct {
+	// Depth is the number of provisioned wallets ready to be claimed
+	Depth        int `json:"depth"`
+	Provisioning int `json:"provisioning"`
+	// Refilled & Claimed count the wallets pooled & claimed in the last hour
+	Refilled int `json:"refilled_1h"`
+	Claimed  int `json:"claimed_1h"`
 }
 
 type WalletStore interface {
 	Create(ctx context.Context, wallet *Wallet) error
+	// Pool creates the wallet as an unclaimed member of the wallet pool
+	Pool(ctx context.Context, wallet *Wallet) error
+	// Claim takes the oldest active wallet from the pool and saves it with the
+	// label, request id, external id & metadata of the given wallet,
+	// sql.ErrNoRows if the pool is dry
+	Claim(ctx context.Context, wallet *Wallet) error
+	// Reserve holds the request id & external id of the wallet before it is
+	// created, ErrWalletReserved if the request is in progress elsewhere
+	Reserve(ctx context.Context, wallet *Wallet) error
+	PoolStats(ctx context.Context) (*WalletPoolStats, error)
+	// UpdateStatus moves the wallet to event.To and records the event
+	UpdateStatus(ctx context.Context, wallet *Wallet, event *WalletEvent) error
+	ListEvents(ctx context.Context, userID string) ([]*WalletEvent, error)
+	// Find may serve the wallet from a cache, its status can lag behind
+	// changes made by other replicas
 	Find(ctx context.Context, userID string) (*Wallet, error)
-	List(ctx context.Context) ([]*Wallet, error)
+	// FindLatest reads the wallet from the master, for decisions on its status
+	FindLatest(ctx context.Context, userID string) (*Wallet, error)
+	FindRequest(ctx context.Context, requestID string) (*Wallet, error)
+	FindExternal(ctx context.Context, externalID string) (*Wallet, error)
+	// List returns wallets without their keystore
+	List(ctx context.Context, query WalletQuery) ([]*Wallet, error)
+	ListStatus(ctx context.Context, status WalletStatus, limit int) ([]*Wallet, error)
+	// ListInactive lists the user ids of frozen & archived wallets and of the
+	// escrow wallets, they are never swept by the rules, topped up or merged
+	ListInactive(ctx context.Context) ([]string, error)
 }
 
 type WalletService interface {
+	// Create registers a new mixin user and generates its pin & spend key,
+	// the returned wallet is still provisioning.
 	Create(ctx context.Context, label string) (*Wallet, error)
+	// Provision sets up the pin & spend key of a provisioning wallet, it is
+	// safe to call again after a partial failure.
+	Provision(ctx context.Context, wallet *Wallet) error
+	// IsInternal reports whether the opponent is the master wallet or one of
+	// its wallets, transfers to them are internal
+	IsInternal(ctx context.Context, opponent *mixin.MixAddress) (bool, error)
 }
 
 type ServiceLoader interface {
@@ -8,6 +8,7 @@ require (
 	github.com/carlmjohnson/versioninfo v0.22.5
 	github.com/fox-one/mixin-sdk-go/v2 v2.0.9
 	github.com/go-chi/chi/v5 v5.1.0
+	github.com/go-sql-driver/mysql v1.8.1
 	github.com/golang-migrate/migrate/v4 v4.17.1
 	github.com/google/uuid v1.6.0
 	github.com/google/wire v0.6.0
@@ -16,6 +17,7 @@ require (
 	github.com/pandodao/generic v1.0.3
 	github.com/rs/cors v1.11.0
 	github.com/shopspring/decimal v1.4.0
+	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.19.0
 	github.com/tsenart/nap v0.0.0-20190313104555-a650a3fbb7be
 	github.com/twitchtv/twirp v8.1.3+incompatible
@@ -32,7 +34,6 @@ require (
 	github.com/fox-one/msgpack v1.0.0 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/go-resty/resty/v2 v2.12.0 // indirect
-	github.com/go-sql-driver/mysql v1.8.1 // indirect
 	github.com/gofrs/uuid v4.4.0+incompatible // indirect
 	github.com/golang-jwt/jwt v3.2.2+incompatible // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
@@ -54,7 +55,6 @@ require (
 	github.com/spf13/afero v1.11.0 // indirect
 	github.com/spf13/cast v1.6.0 // indirect
 	github.com/spf13/cobra v1.8.1
-	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/vmihailenco/tagparser v0.1.2 // indirect
 	github.com/zeebo/blake3 v0.2.3 // indirect
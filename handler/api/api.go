@@ -26,14 +26,62 @@ func (s *Server) Handler() http.Handler {
 	r := chi.NewRouter()
 
 	r.Route("/transfers", func(r chi.Router) {
+		r.Get("/", s.rt.Handle("ListTransfers", nil))
 		r.Get("/{trace_id}", s.rt.Handle("FindTransfer", nil))
 		r.Post("/", s.rt.Handle("CreateTransfer", nil))
+		r.Post("/holds", s.rt.Handle("HoldTransfer", nil))
+		r.Post("/{trace_id}/capture", s.rt.Handle("CaptureTransfer", nil))
+		r.Post("/{trace_id}/release", s.rt.Handle("ReleaseTransfer", nil))
 	})
 
 	r.Route("/wallets", func(r chi.Router) {
+		r.Get("/", s.rt.Handle("ListWallets", nil))
 		r.Post("/", s.rt.Handle("CreateWallet", nil))
+		r.Get("/external/{external_id}", s.rt.Handle("FindWalletByExternalID", nil))
 		r.Get("/{user_id}", s.rt.Handle("FindWallet", nil))
+		r.Post("/{user_id}/freeze", s.rt.Handle("FreezeWallet", nil))
+		r.Post("/{user_id}/unfreeze", s.rt.Handle("UnfreezeWallet", nil))
+		r.Post("/{user_id}/archive", s.rt.Handle("ArchiveWallet", nil))
+		r.Post("/{user_id}/sweep", s.rt.Handle("SweepWallet", nil))
+		r.Get("/{user_id}/outputs", s.rt.Handle("ListOutputs", nil))
+		r.Get("/{user_id}/topups", s.rt.Handle("ListTopupRules", nil))
+		r.Put("/{user_id}/topups/{asset_id}", s.rt.Handle("SetTopupRule", nil))
+		r.Delete("/{user_id}/topups/{asset_id}", s.rt.Handle("DeleteTopupRule", nil))
+		r.Get("/{user_id}/route_rules", s.rt.Handle("ListRouteRules", nil))
+		r.Post("/{user_id}/route_rules", s.rt.Handle("SetRouteRule", nil))
+		r.Put("/{user_id}/route_rules/{id}", s.rt.Handle("SetRouteRule", nil))
+		r.Get("/{user_id}/deposits", s.rt.Handle("ListDepositRoutes", nil))
+		r.Get("/{user_id}/deposits/unmatched", s.rt.Handle("ListUnmatchedDeposits", nil))
+		r.Get("/{user_id}/accounts", s.rt.Handle("ListAccountBalances", nil))
+		r.Get("/{user_id}/accounts/{account}", s.rt.Handle("ListAccountBalances", nil))
+		r.Get("/{user_id}/ledger", s.rt.Handle("ListLedgerEntries", nil))
+		r.Post("/{user_id}/accounts/transfers", s.rt.Handle("InternalTransfer", nil))
+		r.Post("/{user_id}/accounts/{account}/withdraw", s.rt.Handle("WithdrawAccount", nil))
 	})
 
+	r.Route("/invoices", func(r chi.Router) {
+		r.Get("/", s.rt.Handle("ListInvoices", nil))
+		r.Get("/{trace_id}", s.rt.Handle("FindInvoice", nil))
+		r.Post("/", s.rt.Handle("CreateInvoice", nil))
+	})
+
+	r.Route("/escrows", func(r chi.Router) {
+		r.Post("/", s.rt.Handle("CreateEscrow", nil))
+		r.Get("/{trace_id}", s.rt.Handle("FindEscrow", nil))
+		r.Post("/{trace_id}/release", s.rt.Handle("ReleaseEscrow", nil))
+		r.Post("/{trace_id}/refund", s.rt.Handle("RefundEscrow", nil))
+	})
+
+	r.Get("/outputs", s.rt.Handle("ListOutputs", nil))
+	r.Post("/outputs/{sequence}/refund", s.rt.Handle("RefundOutput", nil))
+	r.Post("/sweeps", s.rt.Handle("SweepAll", nil))
+	r.Get("/topups", s.rt.Handle("ListTopupRules", nil))
+	r.Get("/route_rules", s.rt.Handle("ListRouteRules", nil))
+	r.Delete("/route_rules/{id}", s.rt.Handle("DeleteRouteRule", nil))
+	r.Get("/deposits", s.rt.Handle("ListDepositRoutes", nil))
+	r.Get("/deposits/unmatched", s.rt.Handle("ListUnmatchedDeposits", nil))
+	r.Post("/deposits/{output_sequence}/assign", s.rt.Handle("AssignDeposit", nil))
+	r.Get("/audit_events", s.rt.Handle("ListAuditEvents", nil))
+
 	return r
 }
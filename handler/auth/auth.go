@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/twitchtv/twirp"
+)
+
+type Config struct {
+	// MaxSkew is the max difference between the timestamp of a signed request and now
+	MaxSkew time.Duration `valid:"required"`
+	// MaxBodySize limits the body of signed requests, which are read into memory
+	MaxBodySize int64 `valid:"required"`
+	// Identities maps verified client certificates to api identities
+	Identities []CertIdentity `valid:"-"`
+}
+
+type CertIdentity struct {
+	// Subject matches the full distinguished name of the certificate subject, e.g.
+	// CN=ops-bot,O=pando. The common name alone isn't enough, any issuer trusted by
+	// the client ca could sign another certificate with the same one.
+	Subject   string   `mapstructure:"subject"`
+	Name      string   `mapstructure:"name"`
+	Scopes    []string `mapstructure:"scopes"`
+	WalletIDs []string `mapstructure:"wallets"`
+}
+
+type contextKey struct{}
+
+type identity struct {
+	key    *core.APIKey
+	signed bool
+}
+
+func WithKey(ctx context.Context, key *core.APIKey, signed bool) context.Context {
+	return context.WithValue(ctx, contextKey{}, &identity{key: key, signed: signed})
+}
+
+func KeyFrom(ctx context.Context) (*core.APIKey, bool) {
+	id, ok := ctx.Value(contextKey{}).(*identity)
+	if !ok {
+		return nil, false
+	}
+
+	return id.key, true
+}
+
+// Signed reports whether the request is authenticated by a signature
+func Signed(ctx context.Context) bool {
+	id, ok := ctx.Value(contextKey{}).(*identity)
+	return ok && id.signed
+}
+
+// Middleware authenticates the request by a mapped client certificate, the signature
+// headers, or the api key in the Authorization header as a bearer token. Keys are
+// cached for a minute, so a revoked key may still work within that time. Nonces
+// of signed requests are kept in the shared nonce store to reject replays.
+func Middleware(keys core.APIKeyStore, nonces core.NonceStore, logger *slog.Logger, cfg Config) func(http.Handler) http.Handler {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	a := &authenticator{
+		keys:    keys,
+		logger:  logger.With("middleware", "auth"),
+		cfg:     cfg,
+		secrets: expirable.NewLRU[string, *core.APIKey](1024, nil, time.Minute),
+		ids:     expirable.NewLRU[uint64, *core.APIKey](1024, nil, time.Minute),
+		nonces:  nonces,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				key    *core.APIKey
+				signed bool
+				err    error
+			)
+
+			if key = a.certIdentity(r); key != nil {
+				// the client certificate authenticates the whole connection like a signature
+				signed = true
+			} else if r.Header.Get(HeaderSignature) != "" {
+				key, err = a.verify(r)
+				signed = true
+			} else {
+				key, err = a.bearer(r)
+			}
+
+			if err != nil {
+				_ = twirp.WriteError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithKey(r.Context(), key, signed)))
+		})
+	}
+}
+
+type authenticator struct {
+	keys    core.APIKeyStore
+	logger  *slog.Logger
+	cfg     Config
+	secrets *expirable.LRU[string, *core.APIKey]
+	ids     *expirable.LRU[uint64, *core.APIKey]
+	nonces  core.NonceStore
+}
+
+// certIdentity returns the identity of the verified client certificate, nil if not mapped
+func (a *authenticator) certIdentity(r *http.Request) *core.APIKey {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+
+	subject := r.TLS.VerifiedChains[0][0].Subject
+	for _, id := range a.cfg.Identities {
+		if id.Subject == subject.String() {
+			return &core.APIKey{
+				Name:      id.Name,
+				Scopes:    id.Scopes,
+				WalletIDs: id.WalletIDs,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *authenticator) bearer(r *http.Request) (*core.APIKey, error) {
+	secret, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || secret == "" {
+		return nil, twirp.Unauthenticated.Error("api key required")
+	}
+
+	if key, ok := a.secrets.Get(secret); ok {
+		return key, nil
+	}
+
+	key, err := a.keys.FindSecret(r.Context(), secret)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.Unauthenticated.Error("invalid api key")
+		}
+
+		a.logger.Error("keys.FindSecret", "err", err)
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	a.secrets.Add(secret, key)
+	return key, nil
+}
+
+// verify checks the signature of the request, the body is read & restored
+func (a *authenticator) verify(r *http.Request) (*core.APIKey, error) {
+	keyID, err := strconv.ParseUint(r.Header.Get(HeaderKeyID), 10, 64)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("invalid api key id")
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return nil, twirp.Unauthenticated.Error("invalid timestamp")
+	}
+
+	skew := time.Since(time.Unix(timestamp, 0))
+	if math.Abs(float64(skew)) > float64(a.cfg.MaxSkew) {
+		return nil, twirp.Unauthenticated.Error("timestamp skewed")
+	}
+
+	nonce := r.Header.Get(HeaderNonce)
+	if nonce == "" || len(nonce) > 64 {
+		return nil, twirp.Unauthenticated.Error("invalid nonce")
+	}
+
+	key, ok := a.ids.Get(keyID)
+	if !ok {
+		if key, err = a.keys.Find(r.Context(), keyID); err != nil {
+			if store.IsErrNotFound(err) {
+				return nil, twirp.Unauthenticated.Error("invalid api key")
+			}
+
+			a.logger.Error("keys.Find", "err", err)
+			return nil, twirp.InternalErrorWith(err)
+		}
+
+		a.ids.Add(keyID, key)
+	}
+
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(http.MaxBytesReader(nil, r.Body, a.cfg.MaxBodySize))
+		_ = r.Body.Close()
+		if err != nil {
+			return nil, twirp.InvalidArgument.Error("request body too large")
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if key.SigningKey == "" {
+		return nil, twirp.Unauthenticated.Error("api key has no signing key")
+	}
+
+	if !VerifySignature(key.SigningKey, r.Header.Get(HeaderSignature), r.Method, r.URL.RequestURI(), r.Header.Get(HeaderTimestamp), nonce, body) {
+		return nil, twirp.Unauthenticated.Error("invalid signature")
+	}
+
+	// the nonce is checked last, so requests with bad signatures can't burn nonces.
+	// It's kept until the timestamp is out of the skew on every replica, the skew
+	// is doubled for the clock drift between them.
+	expires := time.Unix(timestamp, 0).Add(2 * a.cfg.MaxSkew)
+	fresh, err := a.nonces.Use(r.Context(), keyID, nonce, expires)
+	if err != nil {
+		a.logger.Error("nonces.Use", "err", err)
+		return nil, twirp.InternalErrorWith(err)
+	}
+
+	if !fresh {
+		return nil, twirp.Unauthenticated.Error("nonce reused")
+	}
+
+	return key, nil
+}
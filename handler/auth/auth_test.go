@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func TestCertIdentity(t *testing.T) {
+	h := Middleware(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		MaxSkew:     time.Minute,
+		MaxBodySize: 1024,
+		Identities: []CertIdentity{
+			{Subject: "CN=ops-bot,O=pando", Name: "ops", Scopes: []string{core.ScopeWalletRead}},
+		},
+	})
+
+	serve := func(subject pkix.Name) (*core.APIKey, int) {
+		var key *core.APIKey
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, _ = KeyFrom(r.Context())
+		})
+
+		r := httptest.NewRequest(http.MethodPost, "/twirp/ListWallets", nil)
+		r.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: subject}}},
+		}
+
+		w := httptest.NewRecorder()
+		h(next).ServeHTTP(w, r)
+		return key, w.Code
+	}
+
+	key, code := serve(pkix.Name{CommonName: "ops-bot", Organization: []string{"pando"}})
+	if code != http.StatusOK || key == nil || key.Name != "ops" || key.Principal() != "cert:ops" {
+		t.Fatalf("full subject not mapped: %d %+v", code, key)
+	}
+
+	// the same common name from another organization isn't the identity
+	if key, code := serve(pkix.Name{CommonName: "ops-bot", Organization: []string{"evil"}}); code == http.StatusOK || key != nil {
+		t.Fatalf("common name alone mapped: %d %+v", code, key)
+	}
+
+	if key, code := serve(pkix.Name{CommonName: "ops-bot"}); code == http.StatusOK || key != nil {
+		t.Fatalf("common name alone mapped: %d %+v", code, key)
+	}
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderKeyID     = "X-Api-Key-Id"
+	HeaderTimestamp = "X-Timestamp"
+	HeaderNonce     = "X-Nonce"
+	HeaderSignature = "X-Signature"
+)
+
+// Signature signs the request with the ed25519 signing key of the api key. The
+// server only keeps the public key, so reading the database isn't enough to forge
+// a signed request.
+func Signature(key ed25519.PrivateKey, method, uri, timestamp, nonce string, body []byte) string {
+	return hex.EncodeToString(ed25519.Sign(key, signPayload(method, uri, timestamp, nonce, body)))
+}
+
+// VerifySignature reports whether the signature is made by the private key of the hex encoded public key
+func VerifySignature(publicKey, signature, method, uri, timestamp, nonce string, body []byte) bool {
+	pub, err := hex.DecodeString(publicKey)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return false
+	}
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return ed25519.Verify(pub, signPayload(method, uri, timestamp, nonce, body), sig)
+}
+
+func signPayload(method, uri, timestamp, nonce string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+	return []byte(strings.Join([]string{method, uri, timestamp, nonce, hex.EncodeToString(bodyHash[:])}, "\n"))
+}
+
+// ParseSigningKey parses the hex encoded seed of an ed25519 signing key
+func ParseSigningKey(s string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(s)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid signing key")
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// Transport authenticates requests with an api key. The requests are signed
+// by SigningKey if KeyID is set, otherwise the secret is sent as a bearer
+// token. Requests are sent as is without both, for clients authenticated by
+// certificates.
+type Transport struct {
+	KeyID      uint64
+	SigningKey ed25519.PrivateKey
+	Secret     string
+	// Base is the underlying transport, http.DefaultTransport if nil
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.KeyID == 0 {
+		if t.Secret == "" {
+			return t.base().RoundTrip(r)
+		}
+
+		r = r.Clone(r.Context())
+		r.Header.Set("Authorization", "Bearer "+t.Secret)
+		return t.base().RoundTrip(r)
+	}
+
+	if len(t.SigningKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("signing key required to sign requests")
+	}
+
+	r = r.Clone(r.Context())
+
+	var body []byte
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	r.Header.Set(HeaderKeyID, strconv.FormatUint(t.KeyID, 10))
+	r.Header.Set(HeaderTimestamp, timestamp)
+	r.Header.Set(HeaderNonce, hex.EncodeToString(nonce))
+	r.Header.Set(HeaderSignature, Signature(
+		t.SigningKey,
+		r.Method,
+		r.URL.RequestURI(),
+		timestamp,
+		r.Header.Get(HeaderNonce),
+		body,
+	))
+
+	return t.base().RoundTrip(r)
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+
+	return http.DefaultTransport
+}
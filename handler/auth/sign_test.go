@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+)
+
+func TestSignature(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	seed[0] = 1
+
+	key, err := ParseSigningKey(hex.EncodeToString(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pub := hex.EncodeToString(key.Public().(ed25519.PublicKey))
+	body := []byte(`{"amount":"1"}`)
+	sig := Signature(key, "POST", "/twirp/CreateTransfer", "100", "n1", body)
+
+	if !VerifySignature(pub, sig, "POST", "/twirp/CreateTransfer", "100", "n1", body) {
+		t.Fatal("valid signature rejected")
+	}
+
+	if VerifySignature(pub, sig, "POST", "/twirp/CreateTransfer", "100", "n1", []byte(`{"amount":"2"}`)) {
+		t.Fatal("signature of a tampered body accepted")
+	}
+
+	if VerifySignature(pub, sig, "POST", "/twirp/CreateTransfer", "100", "n2", body) {
+		t.Fatal("signature of another nonce accepted")
+	}
+
+	other, _, _ := ed25519.GenerateKey(nil)
+	if VerifySignature(hex.EncodeToString(other), sig, "POST", "/twirp/CreateTransfer", "100", "n1", body) {
+		t.Fatal("signature verified by another key")
+	}
+
+	if _, err := ParseSigningKey("abc"); err == nil {
+		t.Fatal("invalid signing key parsed")
+	}
+}
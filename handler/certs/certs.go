@@ -0,0 +1,141 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mutual tls, client certificates are verified against it
+	ClientCAFile string
+	// RequireClientCert rejects connections without a client certificate
+	RequireClientCert bool
+}
+
+// NewTLSConfig returns a tls config that reloads the certificate & client ca files
+// once they change, the files are checked at most every ten seconds.
+func NewTLSConfig(cfg Config, logger *slog.Logger) (*tls.Config, error) {
+	r := &reloader{
+		cfg:    cfg,
+		logger: logger.With("tls", cfg.CertFile),
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.config(), nil
+		},
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return &r.config().Certificates[0], nil
+		},
+	}, nil
+}
+
+type reloader struct {
+	cfg    Config
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	tls     *tls.Config
+	modTime time.Time
+	checked time.Time
+}
+
+func (r *reloader) config() *tls.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checked) < 10*time.Second {
+		return r.tls
+	}
+
+	r.checked = time.Now()
+	if modTime, err := r.lastModified(); err != nil {
+		r.logger.Error("stat tls files", "err", err)
+	} else if modTime.After(r.modTime) {
+		// keep serving the old certificate if the new files are broken
+		if err := r.load(); err != nil {
+			r.logger.Error("reload tls files", "err", err)
+		} else {
+			r.logger.Info("tls files reloaded")
+		}
+	}
+
+	return r.tls
+}
+
+func (r *reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+
+	return files
+}
+
+func (r *reloader) lastModified() (time.Time, error) {
+	var last time.Time
+	for _, name := range r.files() {
+		info, err := os.Stat(name)
+		if err != nil {
+			return last, err
+		}
+
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+
+	return last, nil
+}
+
+func (r *reloader) load() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	c := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if r.cfg.ClientCAFile != "" {
+		b, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("no certificates in %s", r.cfg.ClientCAFile)
+		}
+
+		c.ClientCAs = pool
+		c.ClientAuth = tls.VerifyClientCertIfGiven
+		if r.cfg.RequireClientCert {
+			c.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	r.tls = c
+	r.modTime = modTime
+	return nil
+}
@@ -0,0 +1,128 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self-signed certificate of the common name & its key
+func writeCert(t *testing.T, certFile, keyFile, name string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), modTime)
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), modTime)
+}
+
+func writeFile(t *testing.T, name string, data []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chtimes(name, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func servedName(t *testing.T, r *reloader) string {
+	t.Helper()
+
+	// skip the check interval
+	r.checked = time.Time{}
+	cert, err := x509.ParseCertificate(r.config().Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert.Subject.CommonName
+}
+
+func TestReloader(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		certFile = filepath.Join(dir, "cert.pem")
+		keyFile  = filepath.Join(dir, "key.pem")
+		now      = time.Now()
+	)
+
+	writeCert(t, certFile, keyFile, "first", now.Add(-time.Hour))
+
+	r := &reloader{
+		cfg:    Config{CertFile: certFile, KeyFile: keyFile},
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	if err := r.load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if name := servedName(t, r); name != "first" {
+		t.Fatalf("served %s", name)
+	}
+
+	writeCert(t, certFile, keyFile, "second", now.Add(-time.Minute))
+	if name := servedName(t, r); name != "second" {
+		t.Fatalf("changed files not reloaded, served %s", name)
+	}
+
+	// broken files keep the last certificate served
+	writeFile(t, certFile, []byte("broken"), now)
+	if name := servedName(t, r); name != "second" {
+		t.Fatalf("broken files replaced the certificate, served %s", name)
+	}
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+	}
+
+	writeCert(t, cfg.CertFile, cfg.KeyFile, "server", time.Now())
+	writeFile(t, cfg.ClientCAFile, []byte("no certificates"), time.Now())
+
+	if _, err := NewTLSConfig(cfg, slog.New(slog.NewTextHandler(io.Discard, nil))); err == nil {
+		t.Fatal("client ca without certificates accepted")
+	}
+
+	cfg.ClientCAFile = cfg.CertFile
+	if _, err := NewTLSConfig(cfg, slog.New(slog.NewTextHandler(io.Discard, nil))); err != nil {
+		t.Fatal(err)
+	}
+}
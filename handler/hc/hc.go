@@ -1,20 +1,35 @@
 package hc
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
 )
 
-func Handler(version string) http.Handler {
+// Probe reports extra status rendered under its name in the health check output
+type Probe func(ctx context.Context) (any, error)
+
+func Handler(version string, probes map[string]Probe) http.Handler {
 	t := time.Now()
 	fn := func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "encoding/json")
-		w.WriteHeader(http.StatusOK)
-		_ = json.NewEncoder(w).Encode(map[string]any{
+		resp := map[string]any{
 			"version": version,
 			"uptime":  time.Since(t).String(),
-		})
+		}
+
+		for name, probe := range probes {
+			v, err := probe(r.Context())
+			if err != nil {
+				v = map[string]string{"error": err.Error()}
+			}
+
+			resp[name] = v
+		}
+
+		w.Header().Set("Content-Type", "encoding/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
 	}
 
 	return http.HandlerFunc(fn)
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// NewMemory returns a limiter keeping at most size buckets in memory, the least
+// recently used buckets are dropped and start full again.
+func NewMemory(size int) core.RateLimiter {
+	buckets, _ := lru.New[string, *bucket](size)
+	return &memory{buckets: buckets}
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type memory struct {
+	mu      sync.Mutex
+	buckets *lru.Cache[string, *bucket]
+}
+
+func (m *memory) Take(_ context.Context, key string, limit core.RateLimit) (time.Duration, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	b, ok := m.buckets.Get(key)
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		m.buckets.Add(key, b)
+	}
+
+	tokens, wait, ok := limit.Take(b.tokens, now.Sub(b.last))
+	b.tokens, b.last = tokens, now
+	return wait, ok, nil
+}
@@ -0,0 +1,172 @@
+package ratelimit
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/twitchtv/twirp"
+)
+
+// stats is the limiter state exposed by expvar, allowed & limited requests by scope
+var stats = expvar.NewMap("ratelimit")
+
+type Config struct {
+	// IP limits the requests of a client ip, before authentication
+	IP core.RateLimit
+	// Key limits the calls of an api key to a method
+	Key core.RateLimit
+	// Methods overrides Key for these methods, the names are case insensitive
+	Methods map[string]core.RateLimit
+	// TrustedProxies are the networks of the proxies whose X-Forwarded-For and
+	// X-Real-IP headers are trusted, the headers of other peers are ignored
+	TrustedProxies []*net.IPNet
+}
+
+func New(limiter core.RateLimiter, logger *slog.Logger, cfg Config) *Limiter {
+	methods := make(map[string]core.RateLimit, len(cfg.Methods))
+	for method, limit := range cfg.Methods {
+		methods[strings.ToLower(method)] = limit
+	}
+
+	cfg.Methods = methods
+
+	return &Limiter{
+		limiter: limiter,
+		logger:  logger.With("middleware", "ratelimit"),
+		cfg:     cfg,
+	}
+}
+
+type Limiter struct {
+	limiter core.RateLimiter
+	logger  *slog.Logger
+	cfg     Config
+}
+
+// take reports the wait if the bucket of the key is empty, the limiter fails open
+func (l *Limiter) take(ctx context.Context, scope, key string, limit core.RateLimit) (time.Duration, bool) {
+	if limit.Rate <= 0 {
+		return 0, true
+	}
+
+	wait, ok, err := l.limiter.Take(ctx, key, limit)
+	if err != nil {
+		l.logger.Error("limiter.Take", "err", err, "key", key)
+		return 0, true
+	}
+
+	if ok {
+		stats.Add("allowed."+scope, 1)
+	} else {
+		stats.Add("limited."+scope, 1)
+	}
+
+	return wait, ok
+}
+
+func exhausted(wait time.Duration) twirp.Error {
+	retryAfter := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+	return twirp.ResourceExhausted.Error("rate limit exceeded").WithMeta("retry_after", retryAfter)
+}
+
+// Middleware limits the requests by client ip
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wait, ok := l.take(r.Context(), "ip", "ip:"+l.clientIP(r), l.cfg.IP); !ok {
+			err := exhausted(wait)
+			w.Header().Set("Retry-After", err.Meta("retry_after"))
+			_ = twirp.WriteError(w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientIP is the peer address without its port, or the client address the
+// trusted proxies forwarded. X-Forwarded-For is read from the right, skipping
+// the trusted proxies, the addresses left of them are set by the client.
+func (l *Limiter) clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	if !l.trusted(ip) {
+		return ip
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+
+			ip = hop
+			if !l.trusted(hop) {
+				break
+			}
+		}
+
+		return ip
+	}
+
+	if real := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(real) != nil {
+		return real
+	}
+
+	return ip
+}
+
+func (l *Limiter) trusted(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, network := range l.cfg.TrustedProxies {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hooks limits the calls by api key & method, the key is set by auth.Middleware
+func (l *Limiter) Hooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			key, ok := auth.KeyFrom(ctx)
+			if !ok {
+				return ctx, nil
+			}
+
+			method, _ := twirp.MethodName(ctx)
+			limit, ok := l.cfg.Methods[strings.ToLower(method)]
+			if !ok {
+				limit = l.cfg.Key
+			}
+
+			bucket := key.Principal() + ":" + method
+			if wait, ok := l.take(ctx, method, bucket, limit); !ok {
+				err := exhausted(wait)
+				_ = twirp.SetHTTPResponseHeader(ctx, "Retry-After", err.Meta("retry_after"))
+				return ctx, err
+			}
+
+			return ctx, nil
+		},
+	}
+}
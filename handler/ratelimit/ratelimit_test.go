@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func TestMiddleware(t *testing.T) {
+	l := New(NewMemory(16), slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		IP: core.RateLimit{Rate: 1, Burst: 2},
+	})
+
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(ip string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/twirp/CreateTransfer", nil)
+		r.RemoteAddr = ip
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		if w := serve("1.1.1.1"); w.Code != http.StatusOK {
+			t.Fatalf("request %d within the burst limited: %d", i, w.Code)
+		}
+	}
+
+	w := serve("1.1.1.1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the burst: %d", w.Code)
+	}
+
+	if w.Header().Get("Retry-After") != "1" {
+		t.Fatalf("retry after %q", w.Header().Get("Retry-After"))
+	}
+
+	if w := serve("2.2.2.2"); w.Code != http.StatusOK {
+		t.Fatalf("another ip limited: %d", w.Code)
+	}
+}
+
+func TestMiddlewareClientIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	l := New(NewMemory(16), slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		IP:             core.RateLimit{Rate: 1, Burst: 1},
+		TrustedProxies: []*net.IPNet{proxies},
+	})
+
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(peer, forwarded string) int {
+		r := httptest.NewRequest(http.MethodPost, "/twirp/CreateTransfer", nil)
+		r.RemoteAddr = peer
+		if forwarded != "" {
+			r.Header.Set("X-Forwarded-For", forwarded)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// the port of the peer is not a part of the key
+	if code := serve("1.1.1.1:1000", ""); code != http.StatusOK {
+		t.Fatalf("first request limited: %d", code)
+	}
+
+	if code := serve("1.1.1.1:1001", ""); code != http.StatusTooManyRequests {
+		t.Fatalf("another port of the same ip: %d", code)
+	}
+
+	// the headers of untrusted peers are ignored
+	if code := serve("1.1.1.1:1002", "3.3.3.3"); code != http.StatusTooManyRequests {
+		t.Fatalf("spoofed forwarded header: %d", code)
+	}
+
+	// trusted proxies forward the client ip, the addresses left of the client are spoofable
+	if code := serve("10.0.0.1:1000", "1.1.1.1, 4.4.4.4, 10.0.0.2"); code != http.StatusOK {
+		t.Fatalf("forwarded client limited: %d", code)
+	}
+
+	if code := serve("10.0.0.3:1000", "2.2.2.2, 4.4.4.4"); code != http.StatusTooManyRequests {
+		t.Fatalf("forwarded client through another proxy: %d", code)
+	}
+}
+
+type countingLimiter struct {
+	core.RateLimiter
+	takes int
+}
+
+func (l *countingLimiter) Take(ctx context.Context, key string, limit core.RateLimit) (time.Duration, bool, error) {
+	l.takes++
+	return l.RateLimiter.Take(ctx, key, limit)
+}
+
+func TestShared(t *testing.T) {
+	shared := &countingLimiter{RateLimiter: NewMemory(16)}
+	l := NewShared(NewMemory(16), shared)
+	limit := core.RateLimit{Rate: 1, Burst: 1}
+
+	if _, ok, _ := l.Take(context.Background(), "a", limit); !ok {
+		t.Fatal("new bucket is not full")
+	}
+
+	// refused by the local bucket, the shared one is not taken
+	if _, ok, _ := l.Take(context.Background(), "a", limit); ok {
+		t.Fatal("empty bucket taken")
+	}
+
+	if shared.takes != 1 {
+		t.Fatalf("expected one shared take, got %d", shared.takes)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	m := NewMemory(1)
+	limit := core.RateLimit{Rate: 1, Burst: 1}
+
+	if _, ok, _ := m.Take(context.Background(), "a", limit); !ok {
+		t.Fatal("new bucket is not full")
+	}
+
+	if _, ok, _ := m.Take(context.Background(), "a", limit); ok {
+		t.Fatal("empty bucket taken")
+	}
+
+	// b evicts a, which starts full again
+	_, _, _ = m.Take(context.Background(), "b", limit)
+	if _, ok, _ := m.Take(context.Background(), "a", limit); !ok {
+		t.Fatal("evicted bucket is not full")
+	}
+}
@@ -0,0 +1,28 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// NewShared checks the buckets of this replica before the shared buckets. A
+// client over the limit on one replica is over the shared limit too, so a
+// flood is refused locally instead of locking the shared buckets.
+func NewShared(local, shared core.RateLimiter) core.RateLimiter {
+	return &sharedLimiter{local: local, shared: shared}
+}
+
+type sharedLimiter struct {
+	local  core.RateLimiter
+	shared core.RateLimiter
+}
+
+func (l *sharedLimiter) Take(ctx context.Context, key string, limit core.RateLimit) (time.Duration, bool, error) {
+	if wait, ok, err := l.local.Take(ctx, key, limit); err != nil || !ok {
+		return wait, ok, err
+	}
+
+	return l.shared.Take(ctx, key, limit)
+}
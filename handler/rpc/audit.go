@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/twitchtv/twirp"
+	"github.com/zyedidia/generic/mapset"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// redactedParams are never written to the audit log
+var redactedParams = mapset.Of(
+	"pin",
+	"pin_token",
+	"private_key",
+	"session_private_key",
+	"spend_key",
+	"secret",
+)
+
+// audited reports whether calls of the method are written to the audit log, reads are not
+func audited(method string) bool {
+	return !strings.HasPrefix(method, "Find") && !strings.HasPrefix(method, "List")
+}
+
+func (s *Server) auditInterceptor(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		method, _ := twirp.MethodName(ctx)
+		if !audited(method) {
+			return next(ctx, req)
+		}
+
+		resp, err := next(ctx, req)
+
+		event := &core.AuditEvent{
+			Actor:   "anonymous",
+			Action:  "rpc." + method,
+			Target:  auditTarget(req, resp),
+			Params:  auditParams(req),
+			Outcome: core.AuditOutcomeOK,
+		}
+
+		if key, ok := auth.KeyFrom(ctx); ok {
+			event.Actor = key.Principal()
+		}
+
+		if err != nil {
+			event.Outcome = auditOutcome(err)
+		}
+
+		// the call is done already, a failed audit is reported but doesn't fail it
+		if err := s.audits.Append(context.WithoutCancel(ctx), event); err != nil {
+			s.logger.Error("audits.Append", "err", err, "action", event.Action, "target", event.Target)
+		}
+
+		return resp, err
+	}
+}
+
+func auditTarget(req, resp any) string {
+	if r, ok := req.(userRequest); ok && r.GetUserId() != "" {
+		return r.GetUserId()
+	}
+
+	if r, ok := req.(interface{ GetTraceId() string }); ok && r.GetTraceId() != "" {
+		return r.GetTraceId()
+	}
+
+	switch r := resp.(type) {
+	case walletResponse:
+		return r.GetWallet().GetUserId()
+	case transferResponse:
+		return r.GetTransfer().GetUserId()
+	}
+
+	if r, ok := req.(interface{ GetAssetId() string }); ok {
+		return r.GetAssetId()
+	}
+
+	return ""
+}
+
+func auditParams(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+
+	var params any
+	if err := json.Unmarshal(b, &params); err != nil {
+		return ""
+	}
+
+	// keys are sorted by json.Marshal, so the params are stable
+	b, _ = json.Marshal(redact(params))
+	return string(b)
+}
+
+// redact replaces the redacted params at any depth, nested messages & lists included
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if redactedParams.Has(key) {
+				v[key] = "[REDACTED]"
+			} else {
+				v[key] = redact(value)
+			}
+		}
+	case []any:
+		for idx, value := range v {
+			v[idx] = redact(value)
+		}
+	}
+
+	return v
+}
+
+func auditOutcome(err error) string {
+	var terr twirp.Error
+	if errors.As(err, &terr) {
+		return fmt.Sprintf("%s: %s", terr.Code(), terr.Msg())
+	}
+
+	return "error: " + err.Error()
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *safewallet.ListAuditEventsRequest) (*safewallet.ListAuditEventsResponse, error) {
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	events, err := s.audits.List(ctx, core.AuditQuery{
+		Offset: req.Offset,
+		Limit:  limit,
+		Actor:  req.Actor,
+		Action: req.Action,
+	})
+	if err != nil {
+		s.logger.Error("audits.List", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListAuditEventsResponse{NextOffset: req.Offset}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &safewallet.AuditEvent{
+			Id:        event.ID,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Target:    event.Target,
+			Params:    event.Params,
+			Outcome:   event.Outcome,
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+
+		resp.NextOffset = event.ID
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/twitchtv/twirp"
+	"github.com/zyedidia/generic/mapset"
+)
+
+// methodScopes is the scope required by each method, methods not listed require admin
+var methodScopes = map[string]string{
+	"CreateTransfer":         core.ScopeTransferCreate,
+	"FindTransfer":           core.ScopeTransferRead,
+	"ListTransfers":          core.ScopeTransferRead,
+	"RefundOutput":           core.ScopeTransferCreate,
+	"HoldTransfer":           core.ScopeTransferCreate,
+	"CaptureTransfer":        core.ScopeTransferCreate,
+	"ReleaseTransfer":        core.ScopeTransferCreate,
+	"CreateWallet":           core.ScopeWalletCreate,
+	"FindWallet":             core.ScopeWalletRead,
+	"FindWalletByExternalID": core.ScopeWalletRead,
+	"ListWallets":            core.ScopeWalletRead,
+	"ListOutputs":            core.ScopeOutputRead,
+	"CreateInvoice":          core.ScopeInvoiceCreate,
+	"FindInvoice":            core.ScopeInvoiceRead,
+	"ListInvoices":           core.ScopeInvoiceRead,
+	"InternalTransfer":       core.ScopeAccountTransfer,
+	"WithdrawAccount":        core.ScopeAccountTransfer,
+	"ListAccountBalances":    core.ScopeAccountRead,
+	"ListLedgerEntries":      core.ScopeAccountRead,
+	"CreateEscrow":           core.ScopeEscrowCreate,
+	"FindEscrow":             core.ScopeEscrowRead,
+	"ReleaseEscrow":          core.ScopeEscrowSettle,
+	"RefundEscrow":           core.ScopeEscrowSettle,
+	"SetRouteRule":           core.ScopeRouteWrite,
+	"DeleteRouteRule":        core.ScopeRouteWrite,
+	"AssignDeposit":          core.ScopeRouteWrite,
+	"ListRouteRules":         core.ScopeRouteRead,
+	"ListDepositRoutes":      core.ScopeRouteRead,
+	"ListUnmatchedDeposits":  core.ScopeRouteRead,
+}
+
+// signedMethods move funds, bearer api keys are not enough for them
+var signedMethods = mapset.Of(
+	"CreateTransfer",
+	"RefundOutput",
+	"HoldTransfer",
+	"CaptureTransfer",
+	"InternalTransfer",
+	"WithdrawAccount",
+	"CreateEscrow",
+	"ReleaseEscrow",
+	"RefundEscrow",
+	"ArchiveWallet",
+	"SweepWallet",
+	"SweepAll",
+)
+
+func methodScope(method string) string {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+
+	return core.ScopeAdmin
+}
+
+// authHooks checks the scope of the api key authenticated by auth.Middleware
+func authHooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			key, ok := auth.KeyFrom(ctx)
+			if !ok {
+				return ctx, twirp.Unauthenticated.Error("api key required")
+			}
+
+			method, _ := twirp.MethodName(ctx)
+			if scope := methodScope(method); !key.HasScope(scope) {
+				return ctx, twirp.PermissionDenied.Errorf("scope %s required", scope)
+			}
+
+			if signedMethods.Has(method) && !auth.Signed(ctx) {
+				return ctx, twirp.Unauthenticated.Error("signed request required")
+			}
+
+			return ctx, nil
+		},
+	}
+}
+
+type userRequest interface {
+	GetUserId() string
+}
+
+type transferResponse interface {
+	GetTransfer() *safewallet.Transfer
+}
+
+type walletResponse interface {
+	GetWallet() *safewallet.Wallet
+}
+
+type invoiceResponse interface {
+	GetInvoice() *safewallet.Invoice
+}
+
+type escrowResponse interface {
+	GetEscrow() *safewallet.Escrow
+}
+
+// walletInterceptor limits keys with wallet ids to these wallets. The user id
+// is taken from the request, or from the response of lookups by other ids.
+// Requests that can't be attributed to a wallet are denied.
+func walletInterceptor(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		key, ok := auth.KeyFrom(ctx)
+		if !ok || len(key.WalletIDs) == 0 {
+			return next(ctx, req)
+		}
+
+		if r, ok := req.(userRequest); ok {
+			if !key.AllowWallet(r.GetUserId()) {
+				return nil, twirp.PermissionDenied.Error("wallet not allowed")
+			}
+
+			return next(ctx, req)
+		}
+
+		method, _ := twirp.MethodName(ctx)
+		if method == "ReleaseEscrow" || method == "RefundEscrow" {
+			// the arbiter settles escrows of any wallet, the handlers limit
+			// other keys to the payer's wallet before settling
+			return next(ctx, req)
+		}
+
+		if method != "FindTransfer" && method != "FindWalletByExternalID" && method != "FindInvoice" && method != "FindEscrow" {
+			return nil, twirp.PermissionDenied.Error("api key is limited to wallets")
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var userID string
+		switch r := resp.(type) {
+		case transferResponse:
+			userID = r.GetTransfer().GetUserId()
+		case walletResponse:
+			userID = r.GetWallet().GetUserId()
+		case invoiceResponse:
+			userID = r.GetInvoice().GetUserId()
+		case escrowResponse:
+			userID = r.GetEscrow().GetUserId()
+		}
+
+		if !key.AllowWallet(userID) {
+			return nil, twirp.PermissionDenied.Error("wallet not allowed")
+		}
+
+		return resp, nil
+	}
+}
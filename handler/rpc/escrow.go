@@ -0,0 +1,302 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxEscrowDeadline = 90 * 24 * time.Hour
+
+// CreateEscrow moves the funds from the payer to a dedicated escrow wallet,
+// they are released to the payees by the arbiter key, or refunded to the
+// payer after the deadline
+func (s *Server) CreateEscrow(ctx context.Context, req *safewallet.CreateEscrowRequest) (*safewallet.CreateEscrowResponse, error) {
+	if s.blockedAssets.Has(req.AssetId) {
+		return nil, twirp.Aborted.Error("asset is blocked")
+	}
+
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	deadline := time.Duration(req.DeadlineIn) * time.Second
+	if deadline <= 0 || deadline > maxEscrowDeadline {
+		return nil, twirp.InvalidArgument.Error("invalid deadline in")
+	}
+
+	if req.ArbiterKeyId == 0 {
+		return nil, twirp.InvalidArgument.Error("arbiter key id required")
+	}
+
+	escrow := &core.Escrow{
+		TraceID:      req.TraceId,
+		PayerID:      req.UserId,
+		AssetID:      req.AssetId,
+		Amount:       generic.Try(decimal.NewFromString(req.Amount)),
+		Memo:         req.Memo,
+		Payee:        generic.Try(mixin.NewMixAddress(req.Payees, uint8(max(req.Threshold, 1)))),
+		ArbiterKeyID: req.ArbiterKeyId,
+		Deadline:     time.Now().Add(deadline),
+		Status:       core.EscrowStatusLocked,
+	}
+
+	// the fund transfer is validated with the payee as opponent, the escrow
+	// wallet is only created once the request is known to be valid
+	if err := s.validateTransfer(ctx, &core.Transfer{
+		TraceID:  escrow.TraceID,
+		UserID:   escrow.PayerID,
+		AssetID:  escrow.AssetID,
+		Amount:   escrow.Amount,
+		Memo:     escrow.Memo,
+		Opponent: escrow.Payee,
+	}); err != nil {
+		return nil, err
+	}
+
+	if arbiter, err := s.keys.Find(ctx, escrow.ArbiterKeyID); err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.InvalidArgument.Error("arbiter key not found")
+		}
+
+		s.logger.Error("keys.Find", "err", err)
+		return nil, err
+	} else if !arbiter.HasScope(core.ScopeEscrowSettle) {
+		return nil, twirp.InvalidArgument.Errorf("arbiter key requires scope %s", core.ScopeEscrowSettle)
+	}
+
+	v, err, _ := s.sf.Do(escrow.TraceID, func() (interface{}, error) {
+		return s.createEscrow(ctx, escrow)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	escrow = v.(*core.Escrow)
+	fund, err := s.transfers.FindTrace(ctx, escrow.FundTransfer().TraceID)
+	if err != nil {
+		s.logger.Error("transfers.FindTrace", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.CreateEscrowResponse{Escrow: viewEscrow(escrow), Fund: viewTransfer(fund)}, nil
+}
+
+// createEscrow is idempotent by the trace id, the escrow is saved after the
+// fund transfer so a retry resumes with the same wallet & transfer
+func (s *Server) createEscrow(ctx context.Context, escrow *core.Escrow) (*core.Escrow, error) {
+	logger := s.logger.With("escrow", escrow.TraceID)
+
+	if existing, err := s.escrows.FindTrace(ctx, escrow.TraceID); err == nil {
+		return existing, nil
+	} else if !store.IsErrNotFound(err) {
+		logger.Error("escrows.FindTrace", "err", err)
+		return nil, err
+	}
+
+	wallet, err := s.createWallet(ctx, &core.Wallet{
+		RequestID: uuid.NewSHA1(uuid.NameSpaceOID, []byte("escrow "+escrow.TraceID)).String(),
+		Label:     "escrow",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	escrow.WalletID = wallet.UserID
+	if err := s.createTransfer(ctx, escrow.FundTransfer()); err != nil {
+		return nil, err
+	}
+
+	if err := s.escrows.Create(ctx, escrow); err != nil {
+		logger.Error("escrows.Create", "err", err)
+		return nil, err
+	}
+
+	return escrow, nil
+}
+
+func (s *Server) FindEscrow(ctx context.Context, req *safewallet.FindEscrowRequest) (*safewallet.FindEscrowResponse, error) {
+	escrow, err := s.findEscrow(ctx, req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.FindEscrowResponse{Escrow: viewEscrow(escrow)}, nil
+}
+
+// ReleaseEscrow sends the funds to the payees, only the arbiter key can release
+// an escrow, before its deadline
+func (s *Server) ReleaseEscrow(ctx context.Context, req *safewallet.ReleaseEscrowRequest) (*safewallet.ReleaseEscrowResponse, error) {
+	escrow, err := s.findEscrow(ctx, req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _ := auth.KeyFrom(ctx)
+	if key.ID != escrow.ArbiterKeyID && !key.AllowWallet(escrow.PayerID) {
+		return nil, twirp.PermissionDenied.Error("wallet not allowed")
+	}
+
+	if escrow.Status == core.EscrowStatusLocked {
+		if key.ID == 0 || key.ID != escrow.ArbiterKeyID {
+			return nil, twirp.PermissionDenied.Error("only the arbiter can release the escrow")
+		}
+
+		if time.Now().After(escrow.Deadline) {
+			return nil, twirp.FailedPrecondition.Error("escrow expired")
+		}
+	}
+
+	transfer, err := s.settleEscrow(ctx, escrow, core.EscrowStatusReleasing, key.Principal())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &safewallet.ReleaseEscrowResponse{Escrow: viewEscrow(escrow)}
+	if transfer != nil {
+		resp.Transfer = viewTransfer(transfer)
+	}
+
+	return resp, nil
+}
+
+// RefundEscrow sends the funds back to the payer, by the arbiter at any time
+// or by anyone after the deadline
+func (s *Server) RefundEscrow(ctx context.Context, req *safewallet.RefundEscrowRequest) (*safewallet.RefundEscrowResponse, error) {
+	escrow, err := s.findEscrow(ctx, req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _ := auth.KeyFrom(ctx)
+	if key.ID != escrow.ArbiterKeyID && !key.AllowWallet(escrow.PayerID) {
+		return nil, twirp.PermissionDenied.Error("wallet not allowed")
+	}
+
+	if escrow.Status == core.EscrowStatusLocked {
+		arbiter := key.ID != 0 && key.ID == escrow.ArbiterKeyID
+		if !arbiter && time.Now().Before(escrow.Deadline) {
+			return nil, twirp.PermissionDenied.Error("only the arbiter can refund the escrow before the deadline")
+		}
+	}
+
+	transfer, err := s.settleEscrow(ctx, escrow, core.EscrowStatusRefunding, key.Principal())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &safewallet.RefundEscrowResponse{Escrow: viewEscrow(escrow)}
+	if transfer != nil {
+		resp.Transfer = viewTransfer(transfer)
+	}
+
+	return resp, nil
+}
+
+// settleEscrow decides a locked escrow and sends its transfer. The decision is
+// made by the optimistic lock on the status, so concurrent releases & refunds
+// can't both win. The transfer is nil while the escrow wallet isn't funded,
+// the escrower worker settles it later.
+func (s *Server) settleEscrow(ctx context.Context, escrow *core.Escrow, decision core.EscrowStatus, actor string) (*core.Transfer, error) {
+	logger := s.logger.With("escrow", escrow.TraceID, "decision", decision)
+
+	if escrow.Status == core.EscrowStatusLocked {
+		if err := s.escrows.UpdateStatus(ctx, escrow, decision, actor); err != nil {
+			// lost the race, continue with the escrow as decided by the winner
+			latest, findErr := s.findEscrow(ctx, escrow.TraceID)
+			if findErr != nil {
+				return nil, findErr
+			}
+
+			if latest.Status == core.EscrowStatusLocked {
+				logger.Error("escrows.UpdateStatus", "err", err)
+				return nil, err
+			}
+
+			*escrow = *latest
+		}
+	}
+
+	settled := core.EscrowStatusReleased
+	if decision == core.EscrowStatusRefunding {
+		settled = core.EscrowStatusRefunded
+	}
+
+	switch escrow.Status {
+	case decision:
+		transfer, err := s.escrowz.Settle(ctx, escrow)
+		if errors.Is(err, core.ErrEscrowUnfunded) {
+			logger.Debug("escrow not funded yet")
+			return nil, nil
+		} else if err != nil {
+			logger.Error("escrowz.Settle", "err", err)
+			return nil, err
+		}
+
+		return transfer, nil
+	case settled:
+		transfer, err := s.transfers.FindTrace(ctx, escrow.SettleTransfer().TraceID)
+		if err != nil {
+			logger.Error("transfers.FindTrace", "err", err)
+			return nil, err
+		}
+
+		return transfer, nil
+	default:
+		return nil, twirp.FailedPrecondition.Errorf("escrow is %s", strings.ToLower(escrow.Status.String()))
+	}
+}
+
+func (s *Server) findEscrow(ctx context.Context, traceID string) (*core.Escrow, error) {
+	escrow, err := s.escrows.FindTrace(ctx, traceID)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("escrow not found")
+		}
+
+		s.logger.Error("escrows.FindTrace", "err", err)
+		return nil, err
+	}
+
+	return escrow, nil
+}
+
+func viewEscrow(escrow *core.Escrow) *safewallet.Escrow {
+	view := &safewallet.Escrow{
+		TraceId:      escrow.TraceID,
+		CreatedAt:    timestamppb.New(escrow.CreatedAt),
+		UserId:       escrow.PayerID,
+		WalletId:     escrow.WalletID,
+		AssetId:      escrow.AssetID,
+		Amount:       escrow.Amount.String(),
+		Memo:         escrow.Memo,
+		ArbiterKeyId: escrow.ArbiterKeyID,
+		Deadline:     timestamppb.New(escrow.Deadline),
+		Status:       safewallet.Escrow_Status(escrow.Status),
+		SettledBy:    escrow.SettledBy,
+	}
+
+	if escrow.Payee != nil {
+		view.Payees = escrow.Payee.Members()
+		view.Threshold = uint32(escrow.Payee.Threshold)
+	}
+
+	if !escrow.SettledAt.IsZero() {
+		view.SettledAt = timestamppb.New(escrow.SettledAt)
+	}
+
+	return view
+}
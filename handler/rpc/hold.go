@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+)
+
+const (
+	defaultHoldExpiry = time.Hour
+	maxHoldExpiry     = 7 * 24 * time.Hour
+)
+
+// HoldTransfer reserves the outputs of a transfer without sending it, the
+// cashier skips held transfers until they are captured
+func (s *Server) HoldTransfer(ctx context.Context, req *safewallet.HoldTransferRequest) (*safewallet.HoldTransferResponse, error) {
+	if s.blockedAssets.Has(req.AssetId) {
+		return nil, twirp.Aborted.Error("asset is blocked")
+	}
+
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	expiry := time.Duration(req.ExpiresIn) * time.Second
+	if expiry == 0 {
+		expiry = defaultHoldExpiry
+	}
+
+	if expiry < 0 || expiry > maxHoldExpiry {
+		return nil, twirp.InvalidArgument.Error("invalid expires in")
+	}
+
+	transfer := &core.Transfer{
+		TraceID:     req.TraceId,
+		UserID:      req.UserId,
+		Status:      core.TransferStatusHeld,
+		AssetID:     req.AssetId,
+		Amount:      generic.Try(decimal.NewFromString(req.Amount)),
+		Memo:        req.Memo,
+		Opponent:    generic.Try(mixin.NewMixAddress(req.Opponents, uint8(max(req.Threshold, 1)))),
+		HoldExpires: time.Now().Add(expiry),
+	}
+
+	if err := s.validateTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	v, err, _ := s.sf.Do(transfer.TraceID, func() (interface{}, error) {
+		return transfer, s.createTransfer(ctx, transfer)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.HoldTransferResponse{Transfer: viewTransfer(v.(*core.Transfer))}, nil
+}
+
+// CaptureTransfer sends a held transfer, for a smaller amount if set. The
+// rest of the reserved outputs goes back to the wallet as change.
+func (s *Server) CaptureTransfer(ctx context.Context, req *safewallet.CaptureTransferRequest) (*safewallet.CaptureTransferResponse, error) {
+	transfer, err := s.findHeldTransfer(ctx, req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch transfer.Status {
+	case core.TransferStatusAssigned, core.TransferStatusHandled:
+		return &safewallet.CaptureTransferResponse{Transfer: viewTransfer(transfer)}, nil
+	case core.TransferStatusReleased:
+		return nil, twirp.FailedPrecondition.Error("transfer released")
+	}
+
+	if time.Now().After(transfer.HoldExpires) {
+		return nil, twirp.FailedPrecondition.Error("hold expired")
+	}
+
+	amount := transfer.Amount
+	if req.Amount != "" {
+		amount = generic.Try(decimal.NewFromString(req.Amount))
+	}
+
+	if !amount.IsPositive() || amount.Truncate(8).LessThan(amount) || amount.GreaterThan(transfer.Amount) {
+		return nil, twirp.InvalidArgument.Error("invalid amount")
+	}
+
+	if err := s.transfers.Capture(ctx, transfer, amount); err != nil {
+		if errors.Is(err, core.ErrTransferNotHeld) {
+			return nil, twirp.FailedPrecondition.Error("transfer released or hold expired")
+		}
+
+		if errors.Is(err, core.ErrLedgerExceedsBalance) {
+			return nil, twirp.FailedPrecondition.Error("balance is allocated to virtual accounts")
+		}
+
+		s.logger.Error("transfers.Capture", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.CaptureTransferResponse{Transfer: viewTransfer(transfer)}, nil
+}
+
+// ReleaseTransfer cancels a held transfer, its outputs are merged back to the wallet
+func (s *Server) ReleaseTransfer(ctx context.Context, req *safewallet.ReleaseTransferRequest) (*safewallet.ReleaseTransferResponse, error) {
+	transfer, err := s.findHeldTransfer(ctx, req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch transfer.Status {
+	case core.TransferStatusReleased:
+		release, err := s.transfers.FindTrace(ctx, transfer.ReleaseTransfer().TraceID)
+		if err != nil {
+			s.logger.Error("transfers.FindTrace", "err", err)
+			return nil, err
+		}
+
+		return &safewallet.ReleaseTransferResponse{Transfer: viewTransfer(transfer), Release: viewTransfer(release)}, nil
+	case core.TransferStatusAssigned, core.TransferStatusHandled:
+		return nil, twirp.FailedPrecondition.Error("transfer captured")
+	}
+
+	release, err := s.transfers.Release(ctx, transfer)
+	if err != nil {
+		if errors.Is(err, core.ErrTransferNotHeld) {
+			return nil, twirp.FailedPrecondition.Error("transfer captured or released")
+		}
+
+		s.logger.Error("transfers.Release", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.ReleaseTransferResponse{Transfer: viewTransfer(transfer), Release: viewTransfer(release)}, nil
+}
+
+func (s *Server) findHeldTransfer(ctx context.Context, traceID string) (*core.Transfer, error) {
+	transfer, err := s.transfers.FindTrace(ctx, traceID)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("transfer not found")
+		}
+
+		s.logger.Error("transfers.FindTrace", "err", err)
+		return nil, err
+	}
+
+	if transfer.HoldExpires.IsZero() {
+		return nil, twirp.FailedPrecondition.Error("transfer is not held")
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultInvoiceExpiry = 24 * time.Hour
+	maxInvoiceExpiry     = 30 * 24 * time.Hour
+)
+
+// CreateInvoice is idempotent by trace id, the memo defaults to the trace id
+func (s *Server) CreateInvoice(ctx context.Context, req *safewallet.CreateInvoiceRequest) (*safewallet.CreateInvoiceResponse, error) {
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	if req.Memo == "" {
+		req.Memo = req.TraceId
+	}
+
+	if _, err := uuid.Parse(req.TraceId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid trace id")
+	}
+
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Errorf("invalid user id: %q", req.UserId)
+	}
+
+	if _, err := uuid.Parse(req.AssetId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid asset id")
+	}
+
+	if s.blockedAssets.Has(req.AssetId) {
+		return nil, twirp.Aborted.Error("asset is blocked")
+	}
+
+	amount := generic.Try(decimal.NewFromString(req.Amount))
+	if !amount.IsPositive() || amount.Truncate(8).LessThan(amount) {
+		return nil, twirp.InvalidArgument.Error("invalid amount")
+	}
+
+	if len(req.Memo) > 200 {
+		return nil, twirp.InvalidArgument.Error("memo too long")
+	}
+
+	expiry := time.Duration(req.ExpiresIn) * time.Second
+	if expiry == 0 {
+		expiry = defaultInvoiceExpiry
+	}
+
+	if expiry < 0 || expiry > maxInvoiceExpiry {
+		return nil, twirp.InvalidArgument.Error("invalid expires in")
+	}
+
+	if invoice, err := s.invoices.FindTrace(ctx, req.TraceId); err == nil {
+		return &safewallet.CreateInvoiceResponse{Invoice: viewInvoice(invoice)}, nil
+	} else if !store.IsErrNotFound(err) {
+		s.logger.Error("invoices.FindTrace", "err", err)
+		return nil, err
+	}
+
+	if req.UserId != s.defaultUserID {
+		wallet, err := s.findWallet(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		if wallet.Status != core.WalletStatusActive {
+			return nil, twirp.FailedPrecondition.Errorf("wallet is %s", strings.ToLower(wallet.Status.String()))
+		}
+	}
+
+	invoice := &core.Invoice{
+		CreatedAt: time.Now(),
+		TraceID:   req.TraceId,
+		UserID:    req.UserId,
+		AssetID:   req.AssetId,
+		Amount:    amount,
+		Memo:      req.Memo,
+		ExpiresAt: time.Now().Add(expiry),
+		Status:    core.InvoiceStatusUnpaid,
+	}
+
+	if err := s.invoices.Create(ctx, invoice); err != nil {
+		if !store.IsErrDuplicate(err) {
+			s.logger.Error("invoices.Create", "err", err)
+			return nil, err
+		}
+
+		// created concurrently with the same trace, or the memo is taken
+		existing, err := s.invoices.FindTrace(ctx, req.TraceId)
+		if err != nil {
+			if store.IsErrNotFound(err) {
+				return nil, twirp.AlreadyExists.Error("memo already used by another invoice")
+			}
+
+			s.logger.Error("invoices.FindTrace", "err", err)
+			return nil, err
+		}
+
+		invoice = existing
+	}
+
+	return &safewallet.CreateInvoiceResponse{Invoice: viewInvoice(invoice)}, nil
+}
+
+func (s *Server) FindInvoice(ctx context.Context, req *safewallet.FindInvoiceRequest) (*safewallet.FindInvoiceResponse, error) {
+	invoice, err := s.invoices.FindTrace(ctx, req.TraceId)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("invoice not found")
+		}
+
+		s.logger.Error("invoices.FindTrace", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.FindInvoiceResponse{Invoice: viewInvoice(invoice)}, nil
+}
+
+func (s *Server) ListInvoices(ctx context.Context, req *safewallet.ListInvoicesRequest) (*safewallet.ListInvoicesResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	invoices, err := s.invoices.List(ctx, core.InvoiceQuery{
+		Offset: req.Offset,
+		Limit:  limit,
+		UserID: req.UserId,
+		Status: core.InvoiceStatus(req.Status),
+	})
+	if err != nil {
+		s.logger.Error("invoices.List", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListInvoicesResponse{NextOffset: req.Offset}
+	for _, invoice := range invoices {
+		resp.Invoices = append(resp.Invoices, viewInvoice(invoice))
+		resp.NextOffset = invoice.ID
+	}
+
+	return resp, nil
+}
+
+func viewInvoice(invoice *core.Invoice) *safewallet.Invoice {
+	view := &safewallet.Invoice{
+		TraceId:    invoice.TraceID,
+		CreatedAt:  timestamppb.New(invoice.CreatedAt),
+		UserId:     invoice.UserID,
+		AssetId:    invoice.AssetID,
+		Amount:     invoice.Amount.String(),
+		Memo:       invoice.Memo,
+		ExpiresAt:  timestamppb.New(invoice.ExpiresAt),
+		Status:     safewallet.Invoice_Status(invoice.Status),
+		Paid:       invoice.Paid.String(),
+		PaymentUri: invoice.PaymentURI(),
+	}
+
+	if !invoice.PaidAt.IsZero() {
+		view.PaidAt = timestamppb.New(invoice.PaidAt)
+	}
+
+	return view
+}
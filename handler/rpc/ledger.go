@@ -0,0 +1,318 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InternalTransfer moves funds between two virtual accounts of a wallet off-chain
+func (s *Server) InternalTransfer(ctx context.Context, req *safewallet.InternalTransferRequest) (*safewallet.InternalTransferResponse, error) {
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	amount, err := validateAccountRequest(req.TraceId, req.UserId, req.AssetId, req.Amount, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range []string{req.FromAccount, req.ToAccount} {
+		if err := core.ValidateAccount(account); err != nil {
+			return nil, twirp.InvalidArgument.Error(err.Error())
+		}
+	}
+
+	if req.FromAccount == req.ToAccount {
+		return nil, twirp.InvalidArgument.Error("from and to accounts are the same")
+	}
+
+	posting := core.NewTransferPosting(req.TraceId, req.UserId, req.AssetId, req.FromAccount, req.ToAccount, amount, req.Memo)
+	if err := s.post(ctx, posting); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.ledger.FindPosting(ctx, posting.TraceID)
+	if err != nil {
+		s.logger.Error("ledger.FindPosting", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.InternalTransferResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, viewLedgerEntry(entry))
+	}
+
+	return resp, nil
+}
+
+// WithdrawAccount debits a virtual account and sends the funds on-chain with
+// a transfer of the same trace id. The debit is reversed if the transfer can't
+// be created, the trace id can't be used again after that.
+func (s *Server) WithdrawAccount(ctx context.Context, req *safewallet.WithdrawAccountRequest) (*safewallet.WithdrawAccountResponse, error) {
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	amount, err := validateAccountRequest(req.TraceId, req.UserId, req.AssetId, req.Amount, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := core.ValidateAccount(req.Account); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if s.blockedAssets.Has(req.AssetId) {
+		return nil, twirp.Aborted.Error("asset is blocked")
+	}
+
+	transfer := &core.Transfer{
+		TraceID:  req.TraceId,
+		UserID:   req.UserId,
+		Status:   core.TransferStatusPending,
+		AssetID:  req.AssetId,
+		Amount:   amount,
+		Memo:     req.Memo,
+		Opponent: generic.Try(mixin.NewMixAddress(req.Opponents, uint8(max(req.Threshold, 1)))),
+	}
+
+	if transfer.Opponent == nil {
+		return nil, twirp.InvalidArgument.Error("invalid opponents & threshold")
+	}
+
+	if transfer.UserID != s.defaultUserID {
+		wallet, err := s.findWallet(ctx, transfer.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if wallet.Status != core.WalletStatusActive {
+			return nil, twirp.FailedPrecondition.Errorf("wallet is %s", strings.ToLower(wallet.Status.String()))
+		}
+	}
+
+	v, err, _ := s.sf.Do(transfer.TraceID, func() (interface{}, error) {
+		return s.withdrawAccount(ctx, transfer, req.Account)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.WithdrawAccountResponse{Transfer: viewTransfer(v.(*core.Transfer))}, nil
+}
+
+func (s *Server) withdrawAccount(ctx context.Context, transfer *core.Transfer, account string) (*core.Transfer, error) {
+	if existing, err := s.transfers.FindTrace(ctx, transfer.TraceID); err == nil {
+		return existing, nil
+	} else if !store.IsErrNotFound(err) {
+		s.logger.Error("transfers.FindTrace", "err", err)
+		return nil, err
+	}
+
+	posting := core.NewWithdrawPosting(transfer.TraceID, transfer.UserID, transfer.AssetID, account, transfer.Amount, transfer.Memo)
+	reversal := posting.Reverse(uuid.NewSHA1(uuid.NameSpaceOID, []byte("reverse "+posting.TraceID)).String())
+
+	if entries, err := s.ledger.FindPosting(ctx, reversal.TraceID); err != nil {
+		s.logger.Error("ledger.FindPosting", "err", err)
+		return nil, err
+	} else if len(entries) > 0 {
+		return nil, twirp.FailedPrecondition.Error("withdrawal reversed, retry with a new trace id")
+	}
+
+	if err := s.post(ctx, posting); err != nil {
+		return nil, err
+	}
+
+	if err := s.createTransfer(ctx, transfer); err != nil {
+		logger := s.logger.With("trace", transfer.TraceID, "account", account)
+		if _, err := s.ledger.Post(ctx, reversal); err != nil {
+			logger.Error("ledger.Post reversal", "err", err)
+		} else {
+			logger.Info("withdrawal reversed")
+		}
+
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (s *Server) ListAccountBalances(ctx context.Context, req *safewallet.ListAccountBalancesRequest) (*safewallet.ListAccountBalancesResponse, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid user id")
+	}
+
+	balances, err := s.ledger.ListBalances(ctx, req.UserId, req.Account)
+	if err != nil {
+		s.logger.Error("ledger.ListBalances", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListAccountBalancesResponse{}
+	for _, balance := range balances {
+		resp.Balances = append(resp.Balances, &safewallet.AccountBalance{
+			UserId:    balance.UserID,
+			Account:   balance.Account,
+			AssetId:   balance.AssetID,
+			Amount:    balance.Amount.String(),
+			UpdatedAt: timestamppb.New(balance.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListLedgerEntries(ctx context.Context, req *safewallet.ListLedgerEntriesRequest) (*safewallet.ListLedgerEntriesResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	entries, err := s.ledger.ListEntries(ctx, core.LedgerQuery{
+		Offset:  req.Offset,
+		Limit:   limit,
+		UserID:  req.UserId,
+		Account: req.Account,
+		AssetID: req.AssetId,
+	})
+	if err != nil {
+		s.logger.Error("ledger.ListEntries", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListLedgerEntriesResponse{NextOffset: req.Offset}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, viewLedgerEntry(entry))
+		resp.NextOffset = entry.ID
+	}
+
+	return resp, nil
+}
+
+// post applies the posting, a posting already applied with the same trace is
+// ignored. The trace can't be reused by a different posting.
+func (s *Server) post(ctx context.Context, posting *core.Posting) error {
+	applied, err := s.ledger.Post(ctx, posting)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrInsufficientAccountBalance):
+			return twirp.Aborted.Error("insufficient account balance")
+		case errors.Is(err, core.ErrLedgerExceedsBalance):
+			return twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		s.logger.Error("ledger.Post", "trace", posting.TraceID, "err", err)
+		return err
+	}
+
+	if applied {
+		return nil
+	}
+
+	entries, err := s.ledger.FindPosting(ctx, posting.TraceID)
+	if err != nil {
+		s.logger.Error("ledger.FindPosting", "trace", posting.TraceID, "err", err)
+		return err
+	}
+
+	if !posting.Same(entries) {
+		return twirp.AlreadyExists.Error("trace id used by another posting")
+	}
+
+	return nil
+}
+
+// checkUnallocated makes sure a transfer of the wallet doesn't spend the funds of its virtual accounts
+func (s *Server) checkUnallocated(ctx context.Context, userID, assetID string, amount decimal.Decimal) error {
+	balances, err := s.ledger.ListBalances(ctx, userID, core.AccountChain)
+	if err != nil {
+		s.logger.Error("ledger.ListBalances", "err", err)
+		return err
+	}
+
+	var allocated decimal.Decimal
+	for _, b := range balances {
+		if b.AssetID == assetID {
+			allocated = b.Amount.Neg()
+		}
+	}
+
+	if !allocated.IsPositive() {
+		return nil
+	}
+
+	sums, err := s.outputs.SumBalances(ctx, userID, assetID)
+	if err != nil {
+		s.logger.Error("outputs.SumBalances", "err", err)
+		return err
+	}
+
+	var total decimal.Decimal
+	for _, b := range sums {
+		total = total.Add(b.Amount)
+	}
+
+	if total.Sub(allocated).LessThan(amount) {
+		return twirp.FailedPrecondition.Error("balance is allocated to virtual accounts")
+	}
+
+	return nil
+}
+
+func validateAccountRequest(traceID, userID, assetID, amount, memo string) (decimal.Decimal, error) {
+	if _, err := uuid.Parse(traceID); err != nil {
+		return decimal.Zero, twirp.InvalidArgument.Error("invalid trace id")
+	}
+
+	if _, err := uuid.Parse(userID); err != nil {
+		return decimal.Zero, twirp.InvalidArgument.Errorf("invalid user id: %q", userID)
+	}
+
+	if _, err := uuid.Parse(assetID); err != nil {
+		return decimal.Zero, twirp.InvalidArgument.Error("invalid asset id")
+	}
+
+	v := generic.Try(decimal.NewFromString(amount))
+	if !v.IsPositive() || v.Truncate(8).LessThan(v) {
+		return decimal.Zero, twirp.InvalidArgument.Error("invalid amount")
+	}
+
+	if len(memo) > 200 {
+		return decimal.Zero, twirp.InvalidArgument.Error("memo too long")
+	}
+
+	return v, nil
+}
+
+func viewLedgerEntry(entry *core.LedgerEntry) *safewallet.LedgerEntry {
+	return &safewallet.LedgerEntry{
+		Id:        entry.ID,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		TraceId:   entry.TraceID,
+		Kind:      safewallet.LedgerEntry_Kind(entry.Kind),
+		UserId:    entry.UserID,
+		Account:   entry.Account,
+		AssetId:   entry.AssetID,
+		Amount:    entry.Amount.String(),
+		Memo:      entry.Memo,
+	}
+}
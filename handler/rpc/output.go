@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListOutputs(ctx context.Context, req *safewallet.ListOutputsRequest) (*safewallet.ListOutputsResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	if req.AssetId != "" {
+		if _, err := uuid.Parse(req.AssetId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid asset id")
+		}
+	}
+
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	outputs, err := s.outputs.Query(ctx, core.OutputQuery{
+		Offset:  req.Offset,
+		Limit:   limit,
+		UserID:  req.UserId,
+		AssetID: req.AssetId,
+		State:   core.OutputState(req.State),
+		Deposit: req.DepositOnly,
+	})
+	if err != nil {
+		s.logger.Error("outputs.Query", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListOutputsResponse{NextOffset: req.Offset}
+	for _, output := range outputs {
+		resp.Outputs = append(resp.Outputs, viewOutput(output))
+		resp.NextOffset = output.Sequence
+	}
+
+	return resp, nil
+}
+
+func viewOutput(output *core.Output) *safewallet.Output {
+	return &safewallet.Output{
+		Sequence:         output.Sequence,
+		CreatedAt:        timestamppb.New(output.CreatedAt),
+		Hash:             output.Hash.String(),
+		Index:            uint32(output.Index),
+		UserId:           output.UserID,
+		AssetId:          output.AssetID,
+		Amount:           output.Amount.String(),
+		State:            safewallet.Output_State(output.State),
+		SpentBy:          output.SpentBy,
+		Senders:          output.Senders,
+		SendersThreshold: uint32(output.SendersThreshold),
+		Extra:            output.Extra,
+		Memo:             output.Memo,
+		InscriptionHash:  output.InscriptionHash,
+	}
+}
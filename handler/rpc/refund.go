@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+)
+
+// RefundOutput sends a deposit back to its senders. The trace is derived from
+// the output, so a deposit is refunded once, partially or in full. Deposits are
+// refundable after their outputs are merged or deleted, until the cleaner
+// deletes them.
+func (s *Server) RefundOutput(ctx context.Context, req *safewallet.RefundOutputRequest) (*safewallet.RefundOutputResponse, error) {
+	if req.UserId == "" {
+		req.UserId = s.defaultUserID
+	}
+
+	deposit, err := s.outputs.FindDeposit(ctx, req.Sequence)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("deposit not found")
+		}
+
+		s.logger.Error("outputs.FindDeposit", "err", err)
+		return nil, err
+	}
+
+	// the key may be limited to the wallet in the request, not to the deposit's
+	if deposit.UserID != req.UserId {
+		return nil, twirp.NotFoundError("deposit not found")
+	}
+
+	memo := fmt.Sprintf("refund %s:%d", deposit.Hash, deposit.Index)
+	trace := uuid.NewSHA1(uuid.NameSpaceOID, []byte(memo)).String()
+
+	if transfer, err := s.transfers.FindTrace(ctx, trace); err == nil {
+		return &safewallet.RefundOutputResponse{Transfer: viewTransfer(transfer)}, nil
+	} else if !store.IsErrNotFound(err) {
+		s.logger.Error("transfers.FindTrace", "err", err)
+		return nil, err
+	}
+
+	if s.blockedAssets.Has(deposit.AssetID) {
+		return nil, twirp.Aborted.Error("asset is blocked")
+	}
+
+	// the output is gone once it is merged or deleted, a quarantined one may
+	// not be a deposit at all
+	if output, err := s.outputs.Find(ctx, deposit.Sequence); err == nil {
+		if output.State == core.OutputStateQuarantined {
+			return nil, twirp.FailedPrecondition.Error("output is quarantined")
+		}
+	} else if !store.IsErrNotFound(err) {
+		s.logger.Error("outputs.Find", "err", err)
+		return nil, err
+	}
+
+	amount := deposit.Amount
+	if req.Amount != "" {
+		amount = generic.Try(decimal.NewFromString(req.Amount))
+	}
+
+	if amount.GreaterThan(deposit.Amount) {
+		return nil, twirp.InvalidArgument.Error("invalid amount")
+	}
+
+	if len(deposit.Senders) == 0 {
+		return nil, twirp.FailedPrecondition.Error("senders of the output unknown")
+	}
+
+	opponent, err := mixin.NewMixAddress(deposit.Senders, max(deposit.SendersThreshold, 1))
+	if err != nil {
+		return nil, twirp.FailedPrecondition.Error("senders of the output unknown")
+	}
+
+	transfer := &core.Transfer{
+		TraceID:  trace,
+		UserID:   deposit.UserID,
+		Status:   core.TransferStatusPending,
+		AssetID:  deposit.AssetID,
+		Amount:   amount,
+		Memo:     req.Memo,
+		Opponent: opponent,
+		RefundOf: deposit.Sequence,
+	}
+
+	if err := s.validateTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	v, err, _ := s.sf.Do(transfer.TraceID, func() (interface{}, error) {
+		return transfer, s.createTransfer(ctx, transfer)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.RefundOutputResponse{Transfer: viewTransfer(v.(*core.Transfer))}, nil
+}
@@ -0,0 +1,257 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetRouteRule creates a rule, or updates the rule with the id
+func (s *Server) SetRouteRule(ctx context.Context, req *safewallet.SetRouteRuleRequest) (*safewallet.SetRouteRuleResponse, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid user id")
+	}
+
+	rule := &core.RouteRule{
+		ID:         req.Id,
+		UserID:     req.UserId,
+		Priority:   int(req.Priority),
+		MemoPrefix: req.MemoPrefix,
+		MemoRegex:  req.MemoRegex,
+		Sender:     req.Sender,
+		AssetID:    req.AssetId,
+		MinAmount:  generic.Try(decimal.NewFromString(req.MinAmount)),
+		MaxAmount:  generic.Try(decimal.NewFromString(req.MaxAmount)),
+		Account:    req.Account,
+	}
+
+	if err := core.ValidateAccount(rule.Account); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if len(rule.MemoPrefix) > 200 {
+		return nil, twirp.InvalidArgument.Error("memo prefix too long")
+	}
+
+	if err := rule.Compile(); err != nil || len(rule.MemoRegex) > 255 {
+		return nil, twirp.InvalidArgument.Error("invalid memo regex")
+	}
+
+	if rule.Sender != "" {
+		if _, err := uuid.Parse(rule.Sender); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid sender")
+		}
+	}
+
+	if rule.AssetID != "" {
+		if _, err := uuid.Parse(rule.AssetID); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid asset id")
+		}
+	}
+
+	if rule.MinAmount.IsNegative() || rule.MaxAmount.IsNegative() {
+		return nil, twirp.InvalidArgument.Error("invalid amount range")
+	}
+
+	if rule.MaxAmount.IsPositive() && rule.MaxAmount.LessThan(rule.MinAmount) {
+		return nil, twirp.InvalidArgument.Error("invalid amount range, max must not be less than min")
+	}
+
+	if rule.ID > 0 {
+		existing, err := s.findRouteRule(ctx, rule.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing.UserID != rule.UserID {
+			return nil, twirp.InvalidArgument.Error("rule belongs to another wallet")
+		}
+	} else if rule.UserID != s.defaultUserID {
+		if _, err := s.findWallet(ctx, rule.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.routes.SaveRule(ctx, rule); err != nil {
+		s.logger.Error("routes.SaveRule", "err", err)
+		return nil, err
+	}
+
+	rule, err := s.findRouteRule(ctx, rule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.SetRouteRuleResponse{Rule: viewRouteRule(rule)}, nil
+}
+
+func (s *Server) DeleteRouteRule(ctx context.Context, req *safewallet.DeleteRouteRuleRequest) (*safewallet.DeleteRouteRuleResponse, error) {
+	if err := s.routes.DeleteRule(ctx, req.Id); err != nil {
+		s.logger.Error("routes.DeleteRule", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.DeleteRouteRuleResponse{}, nil
+}
+
+func (s *Server) ListRouteRules(ctx context.Context, req *safewallet.ListRouteRulesRequest) (*safewallet.ListRouteRulesResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	rules, err := s.routes.ListRules(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("routes.ListRules", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListRouteRulesResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, viewRouteRule(rule))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListDepositRoutes(ctx context.Context, req *safewallet.ListDepositRoutesRequest) (*safewallet.ListDepositRoutesResponse, error) {
+	routes, next, err := s.listDepositRoutes(ctx, req.Offset, req.Limit, req.UserId, req.Account, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.ListDepositRoutesResponse{Routes: routes, NextOffset: next}, nil
+}
+
+// ListUnmatchedDeposits lists the deposits that match no rule, they wait to be assigned
+func (s *Server) ListUnmatchedDeposits(ctx context.Context, req *safewallet.ListUnmatchedDepositsRequest) (*safewallet.ListUnmatchedDepositsResponse, error) {
+	routes, next, err := s.listDepositRoutes(ctx, req.Offset, req.Limit, req.UserId, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.ListUnmatchedDepositsResponse{Routes: routes, NextOffset: next}, nil
+}
+
+func (s *Server) AssignDeposit(ctx context.Context, req *safewallet.AssignDepositRequest) (*safewallet.AssignDepositResponse, error) {
+	if err := core.ValidateAccount(req.Account); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	route, err := s.routes.FindRoute(ctx, req.OutputSequence)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("deposit not found")
+		}
+
+		s.logger.Error("routes.FindRoute", "err", err)
+		return nil, err
+	}
+
+	if route.Account != "" && route.Account != req.Account {
+		return nil, twirp.FailedPrecondition.Errorf("deposit already credited to %s", route.Account)
+	}
+
+	if route.Account == "" {
+		key, _ := auth.KeyFrom(ctx)
+		if err := s.routes.Assign(ctx, route, req.Account, key.Principal()); err != nil {
+			s.logger.Error("routes.Assign", "err", err)
+			return nil, err
+		}
+
+		s.logger.Info("deposit assigned", "sequence", route.OutputSequence, "account", route.Account, "operator", route.Operator)
+	}
+
+	// posted again on retries, in case the last credit failed
+	if err := s.post(ctx, route.Posting()); err != nil {
+		return nil, err
+	}
+
+	return &safewallet.AssignDepositResponse{Route: viewDepositRoute(route)}, nil
+}
+
+func (s *Server) findRouteRule(ctx context.Context, id uint64) (*core.RouteRule, error) {
+	rule, err := s.routes.FindRule(ctx, id)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("rule not found")
+		}
+
+		s.logger.Error("routes.FindRule", "err", err)
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *Server) listDepositRoutes(ctx context.Context, offset uint64, limit int32, userID, account string, unmatched bool) ([]*safewallet.DepositRoute, uint64, error) {
+	if userID != "" {
+		if _, err := uuid.Parse(userID); err != nil {
+			return nil, 0, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	const maxLimit = 500
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	routes, err := s.routes.ListRoutes(ctx, core.DepositRouteQuery{
+		Offset:    offset,
+		Limit:     int(limit),
+		UserID:    userID,
+		Account:   account,
+		Unmatched: unmatched,
+	})
+	if err != nil {
+		s.logger.Error("routes.ListRoutes", "err", err)
+		return nil, 0, err
+	}
+
+	views := make([]*safewallet.DepositRoute, 0, len(routes))
+	for _, route := range routes {
+		views = append(views, viewDepositRoute(route))
+		offset = route.OutputSequence
+	}
+
+	return views, offset, nil
+}
+
+func viewRouteRule(rule *core.RouteRule) *safewallet.RouteRule {
+	return &safewallet.RouteRule{
+		Id:         rule.ID,
+		CreatedAt:  timestamppb.New(rule.CreatedAt),
+		UserId:     rule.UserID,
+		Priority:   int32(rule.Priority),
+		MemoPrefix: rule.MemoPrefix,
+		MemoRegex:  rule.MemoRegex,
+		Sender:     rule.Sender,
+		AssetId:    rule.AssetID,
+		MinAmount:  rule.MinAmount.String(),
+		MaxAmount:  rule.MaxAmount.String(),
+		Account:    rule.Account,
+	}
+}
+
+func viewDepositRoute(route *core.DepositRoute) *safewallet.DepositRoute {
+	return &safewallet.DepositRoute{
+		OutputSequence: route.OutputSequence,
+		CreatedAt:      timestamppb.New(route.CreatedAt),
+		UserId:         route.UserID,
+		AssetId:        route.AssetID,
+		Amount:         route.Amount.String(),
+		Memo:           route.Memo,
+		Account:        route.Account,
+		RuleId:         route.RuleID,
+		Operator:       route.Operator,
+	}
+}
@@ -2,16 +2,20 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/google/uuid"
 	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/ratelimit"
 	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
 	"github.com/pandodao/safe-wallet/store"
 	"github.com/shopspring/decimal"
@@ -32,11 +36,21 @@ func New(
 	transfers core.TransferStore,
 	wallets core.WalletStore,
 	walletz core.WalletService,
+	sweepz core.SweepService,
+	topups core.TopupStore,
+	audits core.AuditStore,
+	invoices core.InvoiceStore,
+	routes core.RouteStore,
+	ledger core.LedgerStore,
+	escrows core.EscrowStore,
+	escrowz core.EscrowService,
+	keys core.APIKeyStore,
+	limiter *ratelimit.Limiter,
 	logger *slog.Logger,
 	cfg Config,
-) *Server {
+) (*Server, error) {
 	if _, err := govalidator.ValidateStruct(cfg); err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	return &Server{
@@ -44,12 +58,22 @@ func New(
 		transfers:     transfers,
 		wallets:       wallets,
 		walletz:       walletz,
+		sweepz:        sweepz,
+		topups:        topups,
+		audits:        audits,
+		invoices:      invoices,
+		routes:        routes,
+		ledger:        ledger,
+		escrows:       escrows,
+		escrowz:       escrowz,
+		keys:          keys,
+		limiter:       limiter,
 		logger:        logger.With("server", "rpc"),
 		sf:            &singleflight.Group{},
 		prefix:        cfg.Prefix,
 		blockedAssets: mapset.Of(cfg.BlockedAssets...),
 		defaultUserID: cfg.ClientID,
-	}
+	}, nil
 }
 
 type Server struct {
@@ -57,6 +81,16 @@ type Server struct {
 	transfers     core.TransferStore
 	wallets       core.WalletStore
 	walletz       core.WalletService
+	sweepz        core.SweepService
+	topups        core.TopupStore
+	audits        core.AuditStore
+	invoices      core.InvoiceStore
+	routes        core.RouteStore
+	ledger        core.LedgerStore
+	escrows       core.EscrowStore
+	escrowz       core.EscrowService
+	keys          core.APIKeyStore
+	limiter       *ratelimit.Limiter
 	logger        *slog.Logger
 	sf            *singleflight.Group
 	blockedAssets mapset.Set[string]
@@ -65,7 +99,11 @@ type Server struct {
 }
 
 func (s *Server) Handler() (string, http.Handler) {
-	svr := safewallet.NewSafeWalletServiceServer(s, twirp.WithServerPathPrefix(s.prefix))
+	svr := safewallet.NewSafeWalletServiceServer(s,
+		twirp.WithServerPathPrefix(s.prefix),
+		twirp.WithServerHooks(twirp.ChainHooks(authHooks(), s.limiter.Hooks())),
+		twirp.WithServerInterceptors(s.auditInterceptor, walletInterceptor),
+	)
 	return svr.PathPrefix(), svr
 }
 
@@ -82,6 +120,52 @@ func (s *Server) FindTransfer(ctx context.Context, req *safewallet.FindTransferR
 	return &safewallet.FindTransferResponse{Transfer: viewTransfer(transfer)}, nil
 }
 
+func (s *Server) ListTransfers(ctx context.Context, req *safewallet.ListTransfersRequest) (*safewallet.ListTransfersResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	if req.AssetId != "" {
+		if _, err := uuid.Parse(req.AssetId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid asset id")
+		}
+	}
+
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	query := core.TransferQuery{
+		Offset:  req.Offset,
+		Limit:   limit,
+		UserID:  req.UserId,
+		AssetID: req.AssetId,
+	}
+
+	if req.Kind != safewallet.Transfer_KIND_NOT_SET {
+		internal := req.Kind == safewallet.Transfer_INTERNAL
+		query.Internal = &internal
+	}
+
+	transfers, err := s.transfers.List(ctx, query)
+	if err != nil {
+		s.logger.Error("transfers.List", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListTransfersResponse{NextOffset: req.Offset}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, viewTransfer(transfer))
+		resp.NextOffset = transfer.ID
+	}
+
+	return resp, nil
+}
+
 func (s *Server) CreateTransfer(ctx context.Context, req *safewallet.CreateTransferRequest) (*safewallet.CreateTransferResponse, error) {
 	if s.blockedAssets.Has(req.AssetId) {
 		return nil, twirp.Aborted.Error("asset is blocked")
@@ -101,39 +185,59 @@ func (s *Server) CreateTransfer(ctx context.Context, req *safewallet.CreateTrans
 		Opponent: generic.Try(mixin.NewMixAddress(req.Opponents, uint8(max(req.Threshold, 1)))),
 	}
 
-	if _, err := uuid.Parse(req.UserId); err != nil {
-		return nil, twirp.InvalidArgument.Errorf("invalid user id: %q", req.UserId)
+	if err := s.validateTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	v, err, _ := s.sf.Do(transfer.TraceID, func() (interface{}, error) {
+		return transfer, s.createTransfer(ctx, transfer)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.CreateTransferResponse{Transfer: viewTransfer(v.(*core.Transfer))}, nil
+}
+
+// validateTransfer checks the transfer built from a request can be sent by its wallet
+func (s *Server) validateTransfer(ctx context.Context, transfer *core.Transfer) error {
+	if _, err := uuid.Parse(transfer.UserID); err != nil {
+		return twirp.InvalidArgument.Errorf("invalid user id: %q", transfer.UserID)
 	}
 
-	if _, err := uuid.Parse(req.TraceId); err != nil {
-		return nil, twirp.InvalidArgument.Error("invalid trace id")
+	if _, err := uuid.Parse(transfer.TraceID); err != nil {
+		return twirp.InvalidArgument.Error("invalid trace id")
 	}
 
-	if _, err := uuid.Parse(req.AssetId); err != nil {
-		return nil, twirp.InvalidArgument.Error("invalid asset id")
+	if _, err := uuid.Parse(transfer.AssetID); err != nil {
+		return twirp.InvalidArgument.Error("invalid asset id")
 	}
 
 	if !transfer.Amount.IsPositive() || transfer.Amount.Truncate(8).LessThan(transfer.Amount) {
-		return nil, twirp.InvalidArgument.Error("invalid amount")
+		return twirp.InvalidArgument.Error("invalid amount")
 	}
 
 	if len(transfer.Memo) > 200 {
-		return nil, twirp.InvalidArgument.Error("memo too long")
+		return twirp.InvalidArgument.Error("memo too long")
 	}
 
 	if transfer.Opponent == nil {
-		return nil, twirp.InvalidArgument.Error("invalid opponents & threshold")
+		return twirp.InvalidArgument.Error("invalid opponents & threshold")
 	}
 
-	v, err, _ := s.sf.Do(transfer.TraceID, func() (interface{}, error) {
-		return transfer, s.createTransfer(ctx, transfer)
-	})
+	if transfer.UserID != s.defaultUserID {
+		wallet, err := s.findWallet(ctx, transfer.UserID)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return nil, err
+		if wallet.Status != core.WalletStatusActive {
+			return twirp.FailedPrecondition.Errorf("wallet is %s", strings.ToLower(wallet.Status.String()))
+		}
 	}
 
-	return &safewallet.CreateTransferResponse{Transfer: viewTransfer(v.(*core.Transfer))}, nil
+	return s.checkUnallocated(ctx, transfer.UserID, transfer.AssetID, transfer.Amount)
 }
 
 func (s *Server) createTransfer(ctx context.Context, transfer *core.Transfer) error {
@@ -146,6 +250,14 @@ func (s *Server) createTransfer(ctx context.Context, transfer *core.Transfer) er
 		return err
 	}
 
+	internal, err := s.walletz.IsInternal(ctx, transfer.Opponent)
+	if err != nil {
+		logger.Error("walletz.IsInternal", "err", err)
+		return err
+	}
+
+	transfer.Internal = internal
+
 	// if status, err := s.transferz.InspectStatus(ctx, transfer.TraceID); err != nil {
 	// 	logger.Error("inspectTransferStatus", "err", err)
 	// 	return err
@@ -205,6 +317,7 @@ func (s *Server) createTransfer(ctx context.Context, transfer *core.Transfer) er
 				Memo:        memo,
 				Opponent:    mixin.RequireNewMixAddress([]string{transfer.UserID}, 1),
 				AssignRange: ranges,
+				Internal:    true,
 			}
 
 			if err := s.transfers.Assign(ctx, merge, offset); err != nil {
@@ -218,6 +331,10 @@ func (s *Server) createTransfer(ctx context.Context, transfer *core.Transfer) er
 
 	transfer.AssignRange = ranges
 	if err := s.transfers.Assign(ctx, transfer, offset); err != nil {
+		if errors.Is(err, core.ErrLedgerExceedsBalance) {
+			return twirp.FailedPrecondition.Error("balance is allocated to virtual accounts")
+		}
+
 		logger.Error("transfers.Assign", "err", err)
 		return err
 	}
@@ -226,25 +343,136 @@ func (s *Server) createTransfer(ctx context.Context, transfer *core.Transfer) er
 }
 
 func (s *Server) CreateWallet(ctx context.Context, req *safewallet.CreateWalletRequest) (*safewallet.CreateWalletResponse, error) {
-	s.logger.Debug("create new wallet", "label", req.Label)
+	if req.RequestId != "" {
+		if _, err := uuid.Parse(req.RequestId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid request id")
+		}
+	} else {
+		req.RequestId = uuid.NewString()
+	}
 
-	wallet, err := s.walletz.Create(ctx, req.Label)
-	if err != nil {
-		s.logger.Error("walletz.Create", "err", err)
-		return nil, err
+	if len(req.ExternalId) > 64 {
+		return nil, twirp.InvalidArgument.Error("external id too long")
 	}
 
-	if err := s.wallets.Create(ctx, wallet); err != nil {
-		s.logger.Error("wallets.Create", "err", err)
+	if req.Metadata != "" && !validMetadata(req.Metadata) {
+		return nil, twirp.InvalidArgument.Error("invalid metadata")
+	}
+
+	template := &core.Wallet{
+		RequestID:  req.RequestId,
+		Label:      req.Label,
+		ExternalID: req.ExternalId,
+		Metadata:   json.RawMessage(req.Metadata),
+	}
+
+	v, err, _ := s.sf.Do("wallet:"+req.RequestId, func() (interface{}, error) {
+		return s.createWallet(ctx, template)
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
+	wallet := v.(*core.Wallet)
 	return &safewallet.CreateWalletResponse{
 		UserId: wallet.UserID,
 		Label:  wallet.Label,
+		Wallet: viewWallet(wallet),
 	}, nil
 }
 
+func (s *Server) createWallet(ctx context.Context, template *core.Wallet) (*core.Wallet, error) {
+	logger := s.logger.With("request", template.RequestID)
+
+	wallet, err := s.wallets.FindRequest(ctx, template.RequestID)
+	if err == nil {
+		logger.Debug("wallet already created", "user", wallet.UserID, "status", wallet.Status)
+		return wallet, s.provisionWallet(ctx, wallet)
+	} else if !store.IsErrNotFound(err) {
+		logger.Error("wallets.FindRequest", "err", err)
+		return nil, err
+	}
+
+	if template.ExternalID != "" {
+		if _, err := s.wallets.FindExternal(ctx, template.ExternalID); err == nil {
+			return nil, twirp.AlreadyExists.Error("external id already used")
+		} else if !store.IsErrNotFound(err) {
+			logger.Error("wallets.FindExternal", "err", err)
+			return nil, err
+		}
+	}
+
+	// reserve the ids before creating the mixin user, so a request losing the
+	// race on them fails here instead of leaving a user whose keys are lost
+	if err := s.wallets.Reserve(ctx, template); err != nil {
+		if errors.Is(err, core.ErrWalletReserved) {
+			return nil, twirp.Aborted.Error("wallet request in progress")
+		}
+
+		if store.IsErrDuplicate(err) {
+			return nil, twirp.AlreadyExists.Error("external id already used")
+		}
+
+		logger.Error("wallets.Reserve", "err", err)
+		return nil, err
+	}
+
+	wallet = &core.Wallet{}
+	*wallet = *template
+	if err := s.wallets.Claim(ctx, wallet); err == nil {
+		logger.Debug("wallet claimed from pool", "user", wallet.UserID)
+		return wallet, nil
+	} else if !store.IsErrNotFound(err) {
+		logger.Error("wallets.Claim", "err", err)
+		return nil, err
+	}
+
+	logger.Debug("wallet pool is dry, create new wallet", "label", template.Label)
+
+	wallet, err = s.walletz.Create(ctx, template.Label)
+	if err != nil {
+		logger.Error("walletz.Create", "err", err)
+		return nil, err
+	}
+
+	// persist the keystore before provisioning, so a retry can resume it
+	wallet.RequestID = template.RequestID
+	wallet.ExternalID = template.ExternalID
+	wallet.Metadata = template.Metadata
+	if err := s.wallets.Create(ctx, wallet); err != nil {
+		logger.Error("wallets.Create", "err", err, "user", wallet.UserID)
+		return nil, err
+	}
+
+	return wallet, s.provisionWallet(ctx, wallet)
+}
+
+func (s *Server) provisionWallet(ctx context.Context, wallet *core.Wallet) error {
+	if wallet.Status != core.WalletStatusProvisioning {
+		return nil
+	}
+
+	logger := s.logger.With("user", wallet.UserID)
+
+	if err := s.walletz.Provision(ctx, wallet); err != nil {
+		logger.Error("walletz.Provision", "err", err)
+		return err
+	}
+
+	if err := s.wallets.UpdateStatus(ctx, wallet, &core.WalletEvent{
+		To:     core.WalletStatusActive,
+		Actor:  "rpc",
+		Reason: "wallet provisioned",
+	}); err != nil {
+		logger.Error("wallets.UpdateStatus", "err", err)
+		return err
+	}
+
+	wallet.Status = core.WalletStatusActive
+	return nil
+}
+
 func (s *Server) FindWallet(ctx context.Context, req *safewallet.FindWalletRequest) (*safewallet.FindWalletResponse, error) {
 	balances, err := s.outputs.SumBalances(ctx, req.UserId, "")
 	if err != nil {
@@ -264,7 +492,7 @@ func (s *Server) FindWallet(ctx context.Context, req *safewallet.FindWalletReque
 }
 
 func viewTransfer(transfer *core.Transfer) *safewallet.Transfer {
-	return &safewallet.Transfer{
+	view := &safewallet.Transfer{
 		TraceId:   transfer.TraceID,
 		CreatedAt: timestamppb.New(transfer.CreatedAt),
 		Status:    safewallet.Transfer_Status(transfer.Status),
@@ -274,5 +502,20 @@ func viewTransfer(transfer *core.Transfer) *safewallet.Transfer {
 		Memo:      transfer.Memo,
 		Opponents: transfer.Opponent.Members(),
 		Threshold: uint32(transfer.Opponent.Threshold),
+		Kind:      safewallet.Transfer_EXTERNAL,
+
+		TxHash:         transfer.TxHash,
+		OutputSequence: transfer.OutputSequence,
+		RefundOf:       transfer.RefundOf,
+	}
+
+	if transfer.Internal {
+		view.Kind = safewallet.Transfer_INTERNAL
 	}
+
+	if !transfer.HoldExpires.IsZero() {
+		view.HoldExpiresAt = timestamppb.New(transfer.HoldExpires)
+	}
+
+	return view
 }
@@ -37,9 +37,77 @@ type SafeWalletService interface {
 
 	FindTransfer(context.Context, *FindTransferRequest) (*FindTransferResponse, error)
 
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+
+	RefundOutput(context.Context, *RefundOutputRequest) (*RefundOutputResponse, error)
+
+	HoldTransfer(context.Context, *HoldTransferRequest) (*HoldTransferResponse, error)
+
+	CaptureTransfer(context.Context, *CaptureTransferRequest) (*CaptureTransferResponse, error)
+
+	ReleaseTransfer(context.Context, *ReleaseTransferRequest) (*ReleaseTransferResponse, error)
+
+	ListOutputs(context.Context, *ListOutputsRequest) (*ListOutputsResponse, error)
+
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
 
 	FindWallet(context.Context, *FindWalletRequest) (*FindWalletResponse, error)
+
+	FindWalletByExternalID(context.Context, *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error)
+
+	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
+
+	FreezeWallet(context.Context, *FreezeWalletRequest) (*FreezeWalletResponse, error)
+
+	UnfreezeWallet(context.Context, *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error)
+
+	ArchiveWallet(context.Context, *ArchiveWalletRequest) (*ArchiveWalletResponse, error)
+
+	SweepWallet(context.Context, *SweepWalletRequest) (*SweepWalletResponse, error)
+
+	SweepAll(context.Context, *SweepAllRequest) (*SweepAllResponse, error)
+
+	SetTopupRule(context.Context, *SetTopupRuleRequest) (*SetTopupRuleResponse, error)
+
+	DeleteTopupRule(context.Context, *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error)
+
+	ListTopupRules(context.Context, *ListTopupRulesRequest) (*ListTopupRulesResponse, error)
+
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+
+	CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error)
+
+	FindInvoice(context.Context, *FindInvoiceRequest) (*FindInvoiceResponse, error)
+
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
+
+	SetRouteRule(context.Context, *SetRouteRuleRequest) (*SetRouteRuleResponse, error)
+
+	DeleteRouteRule(context.Context, *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error)
+
+	ListRouteRules(context.Context, *ListRouteRulesRequest) (*ListRouteRulesResponse, error)
+
+	ListDepositRoutes(context.Context, *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error)
+
+	ListUnmatchedDeposits(context.Context, *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error)
+
+	AssignDeposit(context.Context, *AssignDepositRequest) (*AssignDepositResponse, error)
+
+	InternalTransfer(context.Context, *InternalTransferRequest) (*InternalTransferResponse, error)
+
+	WithdrawAccount(context.Context, *WithdrawAccountRequest) (*WithdrawAccountResponse, error)
+
+	ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error)
+
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+
+	CreateEscrow(context.Context, *CreateEscrowRequest) (*CreateEscrowResponse, error)
+
+	FindEscrow(context.Context, *FindEscrowRequest) (*FindEscrowResponse, error)
+
+	ReleaseEscrow(context.Context, *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error)
+
+	RefundEscrow(context.Context, *RefundEscrowRequest) (*RefundEscrowResponse, error)
 }
 
 // =================================
@@ -48,7 +116,7 @@ type SafeWalletService interface {
 
 type safeWalletServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [4]string
+	urls        [38]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -76,11 +144,45 @@ func NewSafeWalletServiceProtobufClient(baseURL string, client HTTPClient, opts
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "github.com.pando.safewallet", "SafeWalletService")
-	urls := [4]string{
+	urls := [38]string{
 		serviceURL + "CreateTransfer",
 		serviceURL + "FindTransfer",
+		serviceURL + "ListTransfers",
+		serviceURL + "RefundOutput",
+		serviceURL + "HoldTransfer",
+		serviceURL + "CaptureTransfer",
+		serviceURL + "ReleaseTransfer",
+		serviceURL + "ListOutputs",
 		serviceURL + "CreateWallet",
 		serviceURL + "FindWallet",
+		serviceURL + "FindWalletByExternalID",
+		serviceURL + "ListWallets",
+		serviceURL + "FreezeWallet",
+		serviceURL + "UnfreezeWallet",
+		serviceURL + "ArchiveWallet",
+		serviceURL + "SweepWallet",
+		serviceURL + "SweepAll",
+		serviceURL + "SetTopupRule",
+		serviceURL + "DeleteTopupRule",
+		serviceURL + "ListTopupRules",
+		serviceURL + "ListAuditEvents",
+		serviceURL + "CreateInvoice",
+		serviceURL + "FindInvoice",
+		serviceURL + "ListInvoices",
+		serviceURL + "SetRouteRule",
+		serviceURL + "DeleteRouteRule",
+		serviceURL + "ListRouteRules",
+		serviceURL + "ListDepositRoutes",
+		serviceURL + "ListUnmatchedDeposits",
+		serviceURL + "AssignDeposit",
+		serviceURL + "InternalTransfer",
+		serviceURL + "WithdrawAccount",
+		serviceURL + "ListAccountBalances",
+		serviceURL + "ListLedgerEntries",
+		serviceURL + "CreateEscrow",
+		serviceURL + "FindEscrow",
+		serviceURL + "ReleaseEscrow",
+		serviceURL + "RefundEscrow",
 	}
 
 	return &safeWalletServiceProtobufClient{
@@ -183,26 +285,26 @@ func (c *safeWalletServiceProtobufClient) callFindTransfer(ctx context.Context,
 	return out, nil
 }
 
-func (c *safeWalletServiceProtobufClient) CreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) ListTransfers(ctx context.Context, in *ListTransfersRequest) (*ListTransfersResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
-	caller := c.callCreateWallet
+	ctx = ctxsetters.WithMethodName(ctx, "ListTransfers")
+	caller := c.callListTransfers
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+		caller = func(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateWalletRequest)
+					typedReq, ok := req.(*ListTransfersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListTransfersRequest) when calling interceptor")
 					}
-					return c.callCreateWallet(ctx, typedReq)
+					return c.callListTransfers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateWalletResponse)
+				typedResp, ok := resp.(*ListTransfersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTransfersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -212,8 +314,8 @@ func (c *safeWalletServiceProtobufClient) CreateWallet(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceProtobufClient) callCreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
-	out := new(CreateWalletResponse)
+func (c *safeWalletServiceProtobufClient) callListTransfers(ctx context.Context, in *ListTransfersRequest) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -229,26 +331,26 @@ func (c *safeWalletServiceProtobufClient) callCreateWallet(ctx context.Context,
 	return out, nil
 }
 
-func (c *safeWalletServiceProtobufClient) FindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) RefundOutput(ctx context.Context, in *RefundOutputRequest) (*RefundOutputResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
-	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
-	caller := c.callFindWallet
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOutput")
+	caller := c.callRefundOutput
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+		caller = func(ctx context.Context, req *RefundOutputRequest) (*RefundOutputResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindWalletRequest)
+					typedReq, ok := req.(*RefundOutputRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOutputRequest) when calling interceptor")
 					}
-					return c.callFindWallet(ctx, typedReq)
+					return c.callRefundOutput(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindWalletResponse)
+				typedResp, ok := resp.(*RefundOutputResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOutputResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -258,8 +360,8 @@ func (c *safeWalletServiceProtobufClient) FindWallet(ctx context.Context, in *Fi
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceProtobufClient) callFindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
-	out := new(FindWalletResponse)
+func (c *safeWalletServiceProtobufClient) callRefundOutput(ctx context.Context, in *RefundOutputRequest) (*RefundOutputResponse, error) {
+	out := new(RefundOutputResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -275,75 +377,118 @@ func (c *safeWalletServiceProtobufClient) callFindWallet(ctx context.Context, in
 	return out, nil
 }
 
-// =============================
-// SafeWalletService JSON Client
-// =============================
-
-type safeWalletServiceJSONClient struct {
-	client      HTTPClient
-	urls        [4]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *safeWalletServiceProtobufClient) HoldTransfer(ctx context.Context, in *HoldTransferRequest) (*HoldTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "HoldTransfer")
+	caller := c.callHoldTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HoldTransferRequest) (*HoldTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HoldTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HoldTransferRequest) when calling interceptor")
+					}
+					return c.callHoldTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HoldTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HoldTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewSafeWalletServiceJSONClient creates a JSON client that implements the SafeWalletService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewSafeWalletServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SafeWalletService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *safeWalletServiceProtobufClient) callHoldTransfer(ctx context.Context, in *HoldTransferRequest) (*HoldTransferResponse, error) {
+	out := new(HoldTransferResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
+	return out, nil
+}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "github.com.pando.safewallet", "SafeWalletService")
-	urls := [4]string{
-		serviceURL + "CreateTransfer",
-		serviceURL + "FindTransfer",
-		serviceURL + "CreateWallet",
-		serviceURL + "FindWallet",
+func (c *safeWalletServiceProtobufClient) CaptureTransfer(ctx context.Context, in *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CaptureTransfer")
+	caller := c.callCaptureTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CaptureTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CaptureTransferRequest) when calling interceptor")
+					}
+					return c.callCaptureTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CaptureTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CaptureTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &safeWalletServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *safeWalletServiceProtobufClient) callCaptureTransfer(ctx context.Context, in *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+	out := new(CaptureTransferResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *safeWalletServiceJSONClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest) (*CreateTransferResponse, error) {
+func (c *safeWalletServiceProtobufClient) ReleaseTransfer(ctx context.Context, in *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
-	caller := c.callCreateTransfer
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseTransfer")
+	caller := c.callReleaseTransfer
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+		caller = func(ctx context.Context, req *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateTransferRequest)
+					typedReq, ok := req.(*ReleaseTransferRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseTransferRequest) when calling interceptor")
 					}
-					return c.callCreateTransfer(ctx, typedReq)
+					return c.callReleaseTransfer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateTransferResponse)
+				typedResp, ok := resp.(*ReleaseTransferResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseTransferResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -353,9 +498,9 @@ func (c *safeWalletServiceJSONClient) CreateTransfer(ctx context.Context, in *Cr
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceJSONClient) callCreateTransfer(ctx context.Context, in *CreateTransferRequest) (*CreateTransferResponse, error) {
-	out := new(CreateTransferResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *safeWalletServiceProtobufClient) callReleaseTransfer(ctx context.Context, in *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+	out := new(ReleaseTransferResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -370,26 +515,26 @@ func (c *safeWalletServiceJSONClient) callCreateTransfer(ctx context.Context, in
 	return out, nil
 }
 
-func (c *safeWalletServiceJSONClient) FindTransfer(ctx context.Context, in *FindTransferRequest) (*FindTransferResponse, error) {
+func (c *safeWalletServiceProtobufClient) ListOutputs(ctx context.Context, in *ListOutputsRequest) (*ListOutputsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
-	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
-	caller := c.callFindTransfer
+	ctx = ctxsetters.WithMethodName(ctx, "ListOutputs")
+	caller := c.callListOutputs
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+		caller = func(ctx context.Context, req *ListOutputsRequest) (*ListOutputsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindTransferRequest)
+					typedReq, ok := req.(*ListOutputsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListOutputsRequest) when calling interceptor")
 					}
-					return c.callFindTransfer(ctx, typedReq)
+					return c.callListOutputs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindTransferResponse)
+				typedResp, ok := resp.(*ListOutputsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListOutputsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -399,9 +544,9 @@ func (c *safeWalletServiceJSONClient) FindTransfer(ctx context.Context, in *Find
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceJSONClient) callFindTransfer(ctx context.Context, in *FindTransferRequest) (*FindTransferResponse, error) {
-	out := new(FindTransferResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *safeWalletServiceProtobufClient) callListOutputs(ctx context.Context, in *ListOutputsRequest) (*ListOutputsResponse, error) {
+	out := new(ListOutputsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -416,7 +561,7 @@ func (c *safeWalletServiceJSONClient) callFindTransfer(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *safeWalletServiceJSONClient) CreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) CreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
 	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
@@ -445,9 +590,9 @@ func (c *safeWalletServiceJSONClient) CreateWallet(ctx context.Context, in *Crea
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceJSONClient) callCreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) callCreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
 	out := new(CreateWalletResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -462,7 +607,7 @@ func (c *safeWalletServiceJSONClient) callCreateWallet(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *safeWalletServiceJSONClient) FindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) FindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
 	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
 	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
@@ -491,9 +636,9 @@ func (c *safeWalletServiceJSONClient) FindWallet(ctx context.Context, in *FindWa
 	return caller(ctx, in)
 }
 
-func (c *safeWalletServiceJSONClient) callFindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
+func (c *safeWalletServiceProtobufClient) callFindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
 	out := new(FindWalletResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -508,123 +653,9464 @@ func (c *safeWalletServiceJSONClient) callFindWallet(ctx context.Context, in *Fi
 	return out, nil
 }
 
-// ================================
-// SafeWalletService Server Handler
-// ================================
-
-type safeWalletServiceServer struct {
-	SafeWalletService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *safeWalletServiceProtobufClient) FindWalletByExternalID(ctx context.Context, in *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindWalletByExternalID")
+	caller := c.callFindWalletByExternalID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletByExternalIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletByExternalIDRequest) when calling interceptor")
+					}
+					return c.callFindWalletByExternalID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletByExternalIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletByExternalIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewSafeWalletServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewSafeWalletServiceServer(svc SafeWalletService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *safeWalletServiceProtobufClient) callFindWalletByExternalID(ctx context.Context, in *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+	out := new(FindWalletByExternalIDResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &safeWalletServiceServer{
-		SafeWalletService: svc,
-		hooks:             serverOpts.Hooks,
-		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:        pathPrefix,
-		jsonSkipDefaults:  jsonSkipDefaults,
-		jsonCamelCase:     jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *safeWalletServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *safeWalletServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+func (c *safeWalletServiceProtobufClient) ListWallets(ctx context.Context, in *ListWalletsRequest) (*ListWalletsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListWallets")
+	caller := c.callListWallets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListWalletsRequest) (*ListWalletsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWalletsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWalletsRequest) when calling interceptor")
+					}
+					return c.callListWallets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWalletsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWalletsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListWallets(ctx context.Context, in *ListWalletsRequest) (*ListWalletsResponse, error) {
+	out := new(ListWalletsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) FreezeWallet(ctx context.Context, in *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FreezeWallet")
+	caller := c.callFreezeWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FreezeWalletRequest) when calling interceptor")
+					}
+					return c.callFreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callFreezeWallet(ctx context.Context, in *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+	out := new(FreezeWalletResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) UnfreezeWallet(ctx context.Context, in *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnfreezeWallet")
+	caller := c.callUnfreezeWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnfreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnfreezeWalletRequest) when calling interceptor")
+					}
+					return c.callUnfreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnfreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnfreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callUnfreezeWallet(ctx context.Context, in *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+	out := new(UnfreezeWalletResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ArchiveWallet(ctx context.Context, in *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveWallet")
+	caller := c.callArchiveWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveWalletRequest) when calling interceptor")
+					}
+					return c.callArchiveWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callArchiveWallet(ctx context.Context, in *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+	out := new(ArchiveWalletResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) SweepWallet(ctx context.Context, in *SweepWalletRequest) (*SweepWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SweepWallet")
+	caller := c.callSweepWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SweepWalletRequest) (*SweepWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepWalletRequest) when calling interceptor")
+					}
+					return c.callSweepWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callSweepWallet(ctx context.Context, in *SweepWalletRequest) (*SweepWalletResponse, error) {
+	out := new(SweepWalletResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) SweepAll(ctx context.Context, in *SweepAllRequest) (*SweepAllResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SweepAll")
+	caller := c.callSweepAll
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SweepAllRequest) (*SweepAllResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepAllRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepAllRequest) when calling interceptor")
+					}
+					return c.callSweepAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepAllResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepAllResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callSweepAll(ctx context.Context, in *SweepAllRequest) (*SweepAllResponse, error) {
+	out := new(SweepAllResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) SetTopupRule(ctx context.Context, in *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetTopupRule")
+	caller := c.callSetTopupRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTopupRuleRequest) when calling interceptor")
+					}
+					return c.callSetTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callSetTopupRule(ctx context.Context, in *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+	out := new(SetTopupRuleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) DeleteTopupRule(ctx context.Context, in *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopupRule")
+	caller := c.callDeleteTopupRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopupRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callDeleteTopupRule(ctx context.Context, in *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+	out := new(DeleteTopupRuleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListTopupRules(ctx context.Context, in *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopupRules")
+	caller := c.callListTopupRules
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopupRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopupRulesRequest) when calling interceptor")
+					}
+					return c.callListTopupRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopupRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopupRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListTopupRules(ctx context.Context, in *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+	out := new(ListTopupRulesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	caller := c.callListAuditEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAuditEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAuditEventsRequest) when calling interceptor")
+					}
+					return c.callListAuditEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAuditEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAuditEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateInvoice")
+	caller := c.callCreateInvoice
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateInvoiceRequest) when calling interceptor")
+					}
+					return c.callCreateInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callCreateInvoice(ctx context.Context, in *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	out := new(CreateInvoiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) FindInvoice(ctx context.Context, in *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindInvoice")
+	caller := c.callFindInvoice
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindInvoiceRequest) when calling interceptor")
+					}
+					return c.callFindInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callFindInvoice(ctx context.Context, in *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+	out := new(FindInvoiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvoices")
+	caller := c.callListInvoices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvoicesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvoicesRequest) when calling interceptor")
+					}
+					return c.callListInvoices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvoicesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvoicesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListInvoices(ctx context.Context, in *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	out := new(ListInvoicesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) SetRouteRule(ctx context.Context, in *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRouteRule")
+	caller := c.callSetRouteRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRouteRuleRequest) when calling interceptor")
+					}
+					return c.callSetRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callSetRouteRule(ctx context.Context, in *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+	out := new(SetRouteRuleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) DeleteRouteRule(ctx context.Context, in *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRouteRule")
+	caller := c.callDeleteRouteRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRouteRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callDeleteRouteRule(ctx context.Context, in *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+	out := new(DeleteRouteRuleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListRouteRules(ctx context.Context, in *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRouteRules")
+	caller := c.callListRouteRules
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRouteRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRouteRulesRequest) when calling interceptor")
+					}
+					return c.callListRouteRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRouteRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRouteRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListRouteRules(ctx context.Context, in *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+	out := new(ListRouteRulesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListDepositRoutes(ctx context.Context, in *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDepositRoutes")
+	caller := c.callListDepositRoutes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDepositRoutesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDepositRoutesRequest) when calling interceptor")
+					}
+					return c.callListDepositRoutes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDepositRoutesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDepositRoutesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListDepositRoutes(ctx context.Context, in *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+	out := new(ListDepositRoutesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListUnmatchedDeposits(ctx context.Context, in *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListUnmatchedDeposits")
+	caller := c.callListUnmatchedDeposits
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListUnmatchedDepositsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListUnmatchedDepositsRequest) when calling interceptor")
+					}
+					return c.callListUnmatchedDeposits(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListUnmatchedDepositsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListUnmatchedDepositsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListUnmatchedDeposits(ctx context.Context, in *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+	out := new(ListUnmatchedDepositsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) AssignDeposit(ctx context.Context, in *AssignDepositRequest) (*AssignDepositResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "AssignDeposit")
+	caller := c.callAssignDeposit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AssignDepositRequest) (*AssignDepositResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AssignDepositRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AssignDepositRequest) when calling interceptor")
+					}
+					return c.callAssignDeposit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AssignDepositResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AssignDepositResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callAssignDeposit(ctx context.Context, in *AssignDepositRequest) (*AssignDepositResponse, error) {
+	out := new(AssignDepositResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) InternalTransfer(ctx context.Context, in *InternalTransferRequest) (*InternalTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "InternalTransfer")
+	caller := c.callInternalTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *InternalTransferRequest) (*InternalTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InternalTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InternalTransferRequest) when calling interceptor")
+					}
+					return c.callInternalTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InternalTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InternalTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callInternalTransfer(ctx context.Context, in *InternalTransferRequest) (*InternalTransferResponse, error) {
+	out := new(InternalTransferResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) WithdrawAccount(ctx context.Context, in *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawAccount")
+	caller := c.callWithdrawAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawAccountRequest) when calling interceptor")
+					}
+					return c.callWithdrawAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WithdrawAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WithdrawAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callWithdrawAccount(ctx context.Context, in *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+	out := new(WithdrawAccountResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccountBalances")
+	caller := c.callListAccountBalances
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountBalancesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountBalancesRequest) when calling interceptor")
+					}
+					return c.callListAccountBalances(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountBalancesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountBalancesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	out := new(ListAccountBalancesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLedgerEntries")
+	caller := c.callListLedgerEntries
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLedgerEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLedgerEntriesRequest) when calling interceptor")
+					}
+					return c.callListLedgerEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLedgerEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLedgerEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	out := new(ListLedgerEntriesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) CreateEscrow(ctx context.Context, in *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateEscrow")
+	caller := c.callCreateEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateEscrowRequest) when calling interceptor")
+					}
+					return c.callCreateEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callCreateEscrow(ctx context.Context, in *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+	out := new(CreateEscrowResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) FindEscrow(ctx context.Context, in *FindEscrowRequest) (*FindEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindEscrow")
+	caller := c.callFindEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindEscrowRequest) (*FindEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindEscrowRequest) when calling interceptor")
+					}
+					return c.callFindEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callFindEscrow(ctx context.Context, in *FindEscrowRequest) (*FindEscrowResponse, error) {
+	out := new(FindEscrowResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseEscrow")
+	caller := c.callReleaseEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseEscrowRequest) when calling interceptor")
+					}
+					return c.callReleaseEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	out := new(ReleaseEscrowResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceProtobufClient) RefundEscrow(ctx context.Context, in *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundEscrow")
+	caller := c.callRefundEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundEscrowRequest) when calling interceptor")
+					}
+					return c.callRefundEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceProtobufClient) callRefundEscrow(ctx context.Context, in *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+	out := new(RefundEscrowResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// SafeWalletService JSON Client
+// =============================
+
+type safeWalletServiceJSONClient struct {
+	client      HTTPClient
+	urls        [38]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSafeWalletServiceJSONClient creates a JSON client that implements the SafeWalletService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewSafeWalletServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SafeWalletService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "github.com.pando.safewallet", "SafeWalletService")
+	urls := [38]string{
+		serviceURL + "CreateTransfer",
+		serviceURL + "FindTransfer",
+		serviceURL + "ListTransfers",
+		serviceURL + "RefundOutput",
+		serviceURL + "HoldTransfer",
+		serviceURL + "CaptureTransfer",
+		serviceURL + "ReleaseTransfer",
+		serviceURL + "ListOutputs",
+		serviceURL + "CreateWallet",
+		serviceURL + "FindWallet",
+		serviceURL + "FindWalletByExternalID",
+		serviceURL + "ListWallets",
+		serviceURL + "FreezeWallet",
+		serviceURL + "UnfreezeWallet",
+		serviceURL + "ArchiveWallet",
+		serviceURL + "SweepWallet",
+		serviceURL + "SweepAll",
+		serviceURL + "SetTopupRule",
+		serviceURL + "DeleteTopupRule",
+		serviceURL + "ListTopupRules",
+		serviceURL + "ListAuditEvents",
+		serviceURL + "CreateInvoice",
+		serviceURL + "FindInvoice",
+		serviceURL + "ListInvoices",
+		serviceURL + "SetRouteRule",
+		serviceURL + "DeleteRouteRule",
+		serviceURL + "ListRouteRules",
+		serviceURL + "ListDepositRoutes",
+		serviceURL + "ListUnmatchedDeposits",
+		serviceURL + "AssignDeposit",
+		serviceURL + "InternalTransfer",
+		serviceURL + "WithdrawAccount",
+		serviceURL + "ListAccountBalances",
+		serviceURL + "ListLedgerEntries",
+		serviceURL + "CreateEscrow",
+		serviceURL + "FindEscrow",
+		serviceURL + "ReleaseEscrow",
+		serviceURL + "RefundEscrow",
+	}
+
+	return &safeWalletServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *safeWalletServiceJSONClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest) (*CreateTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
+	caller := c.callCreateTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+					}
+					return c.callCreateTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callCreateTransfer(ctx context.Context, in *CreateTransferRequest) (*CreateTransferResponse, error) {
+	out := new(CreateTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FindTransfer(ctx context.Context, in *FindTransferRequest) (*FindTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
+	caller := c.callFindTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+					}
+					return c.callFindTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFindTransfer(ctx context.Context, in *FindTransferRequest) (*FindTransferResponse, error) {
+	out := new(FindTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListTransfers(ctx context.Context, in *ListTransfersRequest) (*ListTransfersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTransfers")
+	caller := c.callListTransfers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTransfersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTransfersRequest) when calling interceptor")
+					}
+					return c.callListTransfers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTransfersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTransfersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListTransfers(ctx context.Context, in *ListTransfersRequest) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) RefundOutput(ctx context.Context, in *RefundOutputRequest) (*RefundOutputResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOutput")
+	caller := c.callRefundOutput
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefundOutputRequest) (*RefundOutputResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOutputRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOutputRequest) when calling interceptor")
+					}
+					return c.callRefundOutput(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOutputResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOutputResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callRefundOutput(ctx context.Context, in *RefundOutputRequest) (*RefundOutputResponse, error) {
+	out := new(RefundOutputResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) HoldTransfer(ctx context.Context, in *HoldTransferRequest) (*HoldTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "HoldTransfer")
+	caller := c.callHoldTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HoldTransferRequest) (*HoldTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HoldTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HoldTransferRequest) when calling interceptor")
+					}
+					return c.callHoldTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HoldTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HoldTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callHoldTransfer(ctx context.Context, in *HoldTransferRequest) (*HoldTransferResponse, error) {
+	out := new(HoldTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) CaptureTransfer(ctx context.Context, in *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CaptureTransfer")
+	caller := c.callCaptureTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CaptureTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CaptureTransferRequest) when calling interceptor")
+					}
+					return c.callCaptureTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CaptureTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CaptureTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callCaptureTransfer(ctx context.Context, in *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+	out := new(CaptureTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ReleaseTransfer(ctx context.Context, in *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseTransfer")
+	caller := c.callReleaseTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseTransferRequest) when calling interceptor")
+					}
+					return c.callReleaseTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callReleaseTransfer(ctx context.Context, in *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+	out := new(ReleaseTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListOutputs(ctx context.Context, in *ListOutputsRequest) (*ListOutputsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListOutputs")
+	caller := c.callListOutputs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListOutputsRequest) (*ListOutputsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListOutputsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListOutputsRequest) when calling interceptor")
+					}
+					return c.callListOutputs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListOutputsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListOutputsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListOutputs(ctx context.Context, in *ListOutputsRequest) (*ListOutputsResponse, error) {
+	out := new(ListOutputsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) CreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
+	caller := c.callCreateWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+					}
+					return c.callCreateWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callCreateWallet(ctx context.Context, in *CreateWalletRequest) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
+	caller := c.callFindWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+					}
+					return c.callFindWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFindWallet(ctx context.Context, in *FindWalletRequest) (*FindWalletResponse, error) {
+	out := new(FindWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FindWalletByExternalID(ctx context.Context, in *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindWalletByExternalID")
+	caller := c.callFindWalletByExternalID
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletByExternalIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletByExternalIDRequest) when calling interceptor")
+					}
+					return c.callFindWalletByExternalID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletByExternalIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletByExternalIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFindWalletByExternalID(ctx context.Context, in *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+	out := new(FindWalletByExternalIDResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListWallets(ctx context.Context, in *ListWalletsRequest) (*ListWalletsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListWallets")
+	caller := c.callListWallets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListWalletsRequest) (*ListWalletsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWalletsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWalletsRequest) when calling interceptor")
+					}
+					return c.callListWallets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWalletsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWalletsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListWallets(ctx context.Context, in *ListWalletsRequest) (*ListWalletsResponse, error) {
+	out := new(ListWalletsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FreezeWallet(ctx context.Context, in *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FreezeWallet")
+	caller := c.callFreezeWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FreezeWalletRequest) when calling interceptor")
+					}
+					return c.callFreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFreezeWallet(ctx context.Context, in *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+	out := new(FreezeWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) UnfreezeWallet(ctx context.Context, in *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnfreezeWallet")
+	caller := c.callUnfreezeWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnfreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnfreezeWalletRequest) when calling interceptor")
+					}
+					return c.callUnfreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnfreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnfreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callUnfreezeWallet(ctx context.Context, in *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+	out := new(UnfreezeWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ArchiveWallet(ctx context.Context, in *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveWallet")
+	caller := c.callArchiveWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveWalletRequest) when calling interceptor")
+					}
+					return c.callArchiveWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callArchiveWallet(ctx context.Context, in *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+	out := new(ArchiveWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) SweepWallet(ctx context.Context, in *SweepWalletRequest) (*SweepWalletResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SweepWallet")
+	caller := c.callSweepWallet
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SweepWalletRequest) (*SweepWalletResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepWalletRequest) when calling interceptor")
+					}
+					return c.callSweepWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callSweepWallet(ctx context.Context, in *SweepWalletRequest) (*SweepWalletResponse, error) {
+	out := new(SweepWalletResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) SweepAll(ctx context.Context, in *SweepAllRequest) (*SweepAllResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SweepAll")
+	caller := c.callSweepAll
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SweepAllRequest) (*SweepAllResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepAllRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepAllRequest) when calling interceptor")
+					}
+					return c.callSweepAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepAllResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepAllResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callSweepAll(ctx context.Context, in *SweepAllRequest) (*SweepAllResponse, error) {
+	out := new(SweepAllResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) SetTopupRule(ctx context.Context, in *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetTopupRule")
+	caller := c.callSetTopupRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTopupRuleRequest) when calling interceptor")
+					}
+					return c.callSetTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callSetTopupRule(ctx context.Context, in *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+	out := new(SetTopupRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) DeleteTopupRule(ctx context.Context, in *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopupRule")
+	caller := c.callDeleteTopupRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopupRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callDeleteTopupRule(ctx context.Context, in *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+	out := new(DeleteTopupRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListTopupRules(ctx context.Context, in *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopupRules")
+	caller := c.callListTopupRules
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopupRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopupRulesRequest) when calling interceptor")
+					}
+					return c.callListTopupRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopupRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopupRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListTopupRules(ctx context.Context, in *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+	out := new(ListTopupRulesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	caller := c.callListAuditEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAuditEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAuditEventsRequest) when calling interceptor")
+					}
+					return c.callListAuditEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAuditEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAuditEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateInvoice")
+	caller := c.callCreateInvoice
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateInvoiceRequest) when calling interceptor")
+					}
+					return c.callCreateInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callCreateInvoice(ctx context.Context, in *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	out := new(CreateInvoiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FindInvoice(ctx context.Context, in *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindInvoice")
+	caller := c.callFindInvoice
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindInvoiceRequest) when calling interceptor")
+					}
+					return c.callFindInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFindInvoice(ctx context.Context, in *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+	out := new(FindInvoiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvoices")
+	caller := c.callListInvoices
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvoicesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvoicesRequest) when calling interceptor")
+					}
+					return c.callListInvoices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvoicesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvoicesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListInvoices(ctx context.Context, in *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	out := new(ListInvoicesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) SetRouteRule(ctx context.Context, in *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRouteRule")
+	caller := c.callSetRouteRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRouteRuleRequest) when calling interceptor")
+					}
+					return c.callSetRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callSetRouteRule(ctx context.Context, in *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+	out := new(SetRouteRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) DeleteRouteRule(ctx context.Context, in *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRouteRule")
+	caller := c.callDeleteRouteRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRouteRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callDeleteRouteRule(ctx context.Context, in *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+	out := new(DeleteRouteRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListRouteRules(ctx context.Context, in *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRouteRules")
+	caller := c.callListRouteRules
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRouteRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRouteRulesRequest) when calling interceptor")
+					}
+					return c.callListRouteRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRouteRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRouteRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListRouteRules(ctx context.Context, in *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+	out := new(ListRouteRulesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListDepositRoutes(ctx context.Context, in *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDepositRoutes")
+	caller := c.callListDepositRoutes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDepositRoutesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDepositRoutesRequest) when calling interceptor")
+					}
+					return c.callListDepositRoutes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDepositRoutesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDepositRoutesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListDepositRoutes(ctx context.Context, in *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+	out := new(ListDepositRoutesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListUnmatchedDeposits(ctx context.Context, in *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListUnmatchedDeposits")
+	caller := c.callListUnmatchedDeposits
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListUnmatchedDepositsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListUnmatchedDepositsRequest) when calling interceptor")
+					}
+					return c.callListUnmatchedDeposits(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListUnmatchedDepositsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListUnmatchedDepositsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListUnmatchedDeposits(ctx context.Context, in *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+	out := new(ListUnmatchedDepositsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) AssignDeposit(ctx context.Context, in *AssignDepositRequest) (*AssignDepositResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "AssignDeposit")
+	caller := c.callAssignDeposit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AssignDepositRequest) (*AssignDepositResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AssignDepositRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AssignDepositRequest) when calling interceptor")
+					}
+					return c.callAssignDeposit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AssignDepositResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AssignDepositResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callAssignDeposit(ctx context.Context, in *AssignDepositRequest) (*AssignDepositResponse, error) {
+	out := new(AssignDepositResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) InternalTransfer(ctx context.Context, in *InternalTransferRequest) (*InternalTransferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "InternalTransfer")
+	caller := c.callInternalTransfer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *InternalTransferRequest) (*InternalTransferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InternalTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InternalTransferRequest) when calling interceptor")
+					}
+					return c.callInternalTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InternalTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InternalTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callInternalTransfer(ctx context.Context, in *InternalTransferRequest) (*InternalTransferResponse, error) {
+	out := new(InternalTransferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) WithdrawAccount(ctx context.Context, in *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawAccount")
+	caller := c.callWithdrawAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawAccountRequest) when calling interceptor")
+					}
+					return c.callWithdrawAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WithdrawAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WithdrawAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callWithdrawAccount(ctx context.Context, in *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+	out := new(WithdrawAccountResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccountBalances")
+	caller := c.callListAccountBalances
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountBalancesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountBalancesRequest) when calling interceptor")
+					}
+					return c.callListAccountBalances(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountBalancesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountBalancesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	out := new(ListAccountBalancesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLedgerEntries")
+	caller := c.callListLedgerEntries
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLedgerEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLedgerEntriesRequest) when calling interceptor")
+					}
+					return c.callListLedgerEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLedgerEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLedgerEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	out := new(ListLedgerEntriesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) CreateEscrow(ctx context.Context, in *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateEscrow")
+	caller := c.callCreateEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateEscrowRequest) when calling interceptor")
+					}
+					return c.callCreateEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callCreateEscrow(ctx context.Context, in *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+	out := new(CreateEscrowResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) FindEscrow(ctx context.Context, in *FindEscrowRequest) (*FindEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "FindEscrow")
+	caller := c.callFindEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindEscrowRequest) (*FindEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindEscrowRequest) when calling interceptor")
+					}
+					return c.callFindEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callFindEscrow(ctx context.Context, in *FindEscrowRequest) (*FindEscrowResponse, error) {
+	out := new(FindEscrowResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseEscrow")
+	caller := c.callReleaseEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseEscrowRequest) when calling interceptor")
+					}
+					return c.callReleaseEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	out := new(ReleaseEscrowResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *safeWalletServiceJSONClient) RefundEscrow(ctx context.Context, in *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundEscrow")
+	caller := c.callRefundEscrow
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundEscrowRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundEscrowRequest) when calling interceptor")
+					}
+					return c.callRefundEscrow(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundEscrowResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundEscrowResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *safeWalletServiceJSONClient) callRefundEscrow(ctx context.Context, in *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+	out := new(RefundEscrowResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// SafeWalletService Server Handler
+// ================================
+
+type safeWalletServiceServer struct {
+	SafeWalletService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSafeWalletServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewSafeWalletServiceServer(svc SafeWalletService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &safeWalletServiceServer{
+		SafeWalletService: svc,
+		hooks:             serverOpts.Hooks,
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:        pathPrefix,
+		jsonSkipDefaults:  jsonSkipDefaults,
+		jsonCamelCase:     jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *safeWalletServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *safeWalletServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// SafeWalletServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const SafeWalletServicePathPrefix = "/twirp/github.com.pando.safewallet.SafeWalletService/"
+
+func (s *safeWalletServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
+	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "github.com.pando.safewallet.SafeWalletService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateTransfer":
+		s.serveCreateTransfer(ctx, resp, req)
+		return
+	case "FindTransfer":
+		s.serveFindTransfer(ctx, resp, req)
+		return
+	case "ListTransfers":
+		s.serveListTransfers(ctx, resp, req)
+		return
+	case "RefundOutput":
+		s.serveRefundOutput(ctx, resp, req)
+		return
+	case "HoldTransfer":
+		s.serveHoldTransfer(ctx, resp, req)
+		return
+	case "CaptureTransfer":
+		s.serveCaptureTransfer(ctx, resp, req)
+		return
+	case "ReleaseTransfer":
+		s.serveReleaseTransfer(ctx, resp, req)
+		return
+	case "ListOutputs":
+		s.serveListOutputs(ctx, resp, req)
+		return
+	case "CreateWallet":
+		s.serveCreateWallet(ctx, resp, req)
+		return
+	case "FindWallet":
+		s.serveFindWallet(ctx, resp, req)
+		return
+	case "FindWalletByExternalID":
+		s.serveFindWalletByExternalID(ctx, resp, req)
+		return
+	case "ListWallets":
+		s.serveListWallets(ctx, resp, req)
+		return
+	case "FreezeWallet":
+		s.serveFreezeWallet(ctx, resp, req)
+		return
+	case "UnfreezeWallet":
+		s.serveUnfreezeWallet(ctx, resp, req)
+		return
+	case "ArchiveWallet":
+		s.serveArchiveWallet(ctx, resp, req)
+		return
+	case "SweepWallet":
+		s.serveSweepWallet(ctx, resp, req)
+		return
+	case "SweepAll":
+		s.serveSweepAll(ctx, resp, req)
+		return
+	case "SetTopupRule":
+		s.serveSetTopupRule(ctx, resp, req)
+		return
+	case "DeleteTopupRule":
+		s.serveDeleteTopupRule(ctx, resp, req)
+		return
+	case "ListTopupRules":
+		s.serveListTopupRules(ctx, resp, req)
+		return
+	case "ListAuditEvents":
+		s.serveListAuditEvents(ctx, resp, req)
+		return
+	case "CreateInvoice":
+		s.serveCreateInvoice(ctx, resp, req)
+		return
+	case "FindInvoice":
+		s.serveFindInvoice(ctx, resp, req)
+		return
+	case "ListInvoices":
+		s.serveListInvoices(ctx, resp, req)
+		return
+	case "SetRouteRule":
+		s.serveSetRouteRule(ctx, resp, req)
+		return
+	case "DeleteRouteRule":
+		s.serveDeleteRouteRule(ctx, resp, req)
+		return
+	case "ListRouteRules":
+		s.serveListRouteRules(ctx, resp, req)
+		return
+	case "ListDepositRoutes":
+		s.serveListDepositRoutes(ctx, resp, req)
+		return
+	case "ListUnmatchedDeposits":
+		s.serveListUnmatchedDeposits(ctx, resp, req)
+		return
+	case "AssignDeposit":
+		s.serveAssignDeposit(ctx, resp, req)
+		return
+	case "InternalTransfer":
+		s.serveInternalTransfer(ctx, resp, req)
+		return
+	case "WithdrawAccount":
+		s.serveWithdrawAccount(ctx, resp, req)
+		return
+	case "ListAccountBalances":
+		s.serveListAccountBalances(ctx, resp, req)
+		return
+	case "ListLedgerEntries":
+		s.serveListLedgerEntries(ctx, resp, req)
+		return
+	case "CreateEscrow":
+		s.serveCreateEscrow(ctx, resp, req)
+		return
+	case "FindEscrow":
+		s.serveFindEscrow(ctx, resp, req)
+		return
+	case "ReleaseEscrow":
+		s.serveReleaseEscrow(ctx, resp, req)
+		return
+	case "RefundEscrow":
+		s.serveRefundEscrow(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *safeWalletServiceServer) serveCreateTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.CreateTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTransferResponse and nil error while calling CreateTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.CreateTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTransferResponse and nil error while calling CreateTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.FindTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindTransferResponse and nil error while calling FindTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.FindTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindTransferResponse and nil error while calling FindTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListTransfers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTransfersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTransfersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListTransfersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTransfers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListTransfersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListTransfers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTransfersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTransfersRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListTransfers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTransfersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTransfersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTransfersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTransfersResponse and nil error while calling ListTransfers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListTransfersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTransfers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListTransfersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListTransfers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTransfersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTransfersRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListTransfers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTransfersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTransfersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTransfersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTransfersResponse and nil error while calling ListTransfers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveRefundOutput(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRefundOutputJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRefundOutputProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveRefundOutputJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOutput")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RefundOutputRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.RefundOutput
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefundOutputRequest) (*RefundOutputResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOutputRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOutputRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.RefundOutput(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOutputResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOutputResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefundOutputResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundOutputResponse and nil error while calling RefundOutput. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveRefundOutputProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefundOutput")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RefundOutputRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.RefundOutput
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefundOutputRequest) (*RefundOutputResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefundOutputRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefundOutputRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.RefundOutput(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefundOutputResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundOutputResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefundOutputResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundOutputResponse and nil error while calling RefundOutput. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveHoldTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveHoldTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveHoldTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveHoldTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HoldTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(HoldTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.HoldTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HoldTransferRequest) (*HoldTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HoldTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HoldTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.HoldTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HoldTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HoldTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HoldTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HoldTransferResponse and nil error while calling HoldTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveHoldTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HoldTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(HoldTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.HoldTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HoldTransferRequest) (*HoldTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HoldTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HoldTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.HoldTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HoldTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HoldTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HoldTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HoldTransferResponse and nil error while calling HoldTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCaptureTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCaptureTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCaptureTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveCaptureTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CaptureTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CaptureTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.CaptureTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CaptureTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CaptureTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CaptureTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CaptureTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CaptureTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CaptureTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CaptureTransferResponse and nil error while calling CaptureTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCaptureTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CaptureTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CaptureTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.CaptureTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CaptureTransferRequest) (*CaptureTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CaptureTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CaptureTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CaptureTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CaptureTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CaptureTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CaptureTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CaptureTransferResponse and nil error while calling CaptureTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveReleaseTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReleaseTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReleaseTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveReleaseTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReleaseTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ReleaseTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ReleaseTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReleaseTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseTransferResponse and nil error while calling ReleaseTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveReleaseTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReleaseTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ReleaseTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReleaseTransferRequest) (*ReleaseTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReleaseTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ReleaseTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReleaseTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReleaseTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseTransferResponse and nil error while calling ReleaseTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListOutputs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListOutputsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListOutputsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListOutputsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListOutputs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListOutputsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListOutputs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListOutputsRequest) (*ListOutputsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListOutputsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListOutputsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListOutputs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListOutputsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListOutputsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListOutputsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListOutputsResponse and nil error while calling ListOutputs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListOutputsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListOutputs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListOutputsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListOutputs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListOutputsRequest) (*ListOutputsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListOutputsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListOutputsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListOutputs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListOutputsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListOutputsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListOutputsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListOutputsResponse and nil error while calling ListOutputs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCreateWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.CreateWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateWalletResponse and nil error while calling CreateWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.CreateWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateWalletResponse and nil error while calling CreateWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.FindWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletResponse and nil error while calling FindWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.FindWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletResponse and nil error while calling FindWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindWalletByExternalID(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindWalletByExternalIDJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindWalletByExternalIDProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveFindWalletByExternalIDJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindWalletByExternalID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindWalletByExternalIDRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.FindWalletByExternalID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletByExternalIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletByExternalIDRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindWalletByExternalID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletByExternalIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletByExternalIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindWalletByExternalIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletByExternalIDResponse and nil error while calling FindWalletByExternalID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindWalletByExternalIDProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindWalletByExternalID")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindWalletByExternalIDRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.FindWalletByExternalID
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindWalletByExternalIDRequest) (*FindWalletByExternalIDResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindWalletByExternalIDRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindWalletByExternalIDRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindWalletByExternalID(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindWalletByExternalIDResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletByExternalIDResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindWalletByExternalIDResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletByExternalIDResponse and nil error while calling FindWalletByExternalID. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListWallets(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListWalletsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListWalletsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListWalletsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListWallets")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListWalletsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListWallets
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListWalletsRequest) (*ListWalletsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWalletsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWalletsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListWallets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWalletsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWalletsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListWalletsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListWalletsResponse and nil error while calling ListWallets. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListWalletsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListWallets")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListWalletsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListWallets
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListWalletsRequest) (*ListWalletsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListWalletsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListWalletsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListWallets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListWalletsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListWalletsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListWalletsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListWalletsResponse and nil error while calling ListWallets. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFreezeWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFreezeWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFreezeWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveFreezeWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FreezeWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FreezeWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.FreezeWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FreezeWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FreezeWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FreezeWalletResponse and nil error while calling FreezeWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFreezeWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FreezeWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FreezeWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.FreezeWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FreezeWalletRequest) (*FreezeWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FreezeWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FreezeWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FreezeWalletResponse and nil error while calling FreezeWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveUnfreezeWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUnfreezeWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUnfreezeWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveUnfreezeWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnfreezeWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UnfreezeWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.UnfreezeWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnfreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnfreezeWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.UnfreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnfreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnfreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnfreezeWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnfreezeWalletResponse and nil error while calling UnfreezeWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveUnfreezeWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnfreezeWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UnfreezeWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.UnfreezeWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnfreezeWalletRequest) (*UnfreezeWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnfreezeWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnfreezeWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.UnfreezeWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnfreezeWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnfreezeWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnfreezeWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnfreezeWalletResponse and nil error while calling UnfreezeWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveArchiveWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveArchiveWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveArchiveWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveArchiveWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ArchiveWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ArchiveWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ArchiveWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveWalletResponse and nil error while calling ArchiveWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveArchiveWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ArchiveWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ArchiveWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveWalletRequest) (*ArchiveWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ArchiveWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveWalletResponse and nil error while calling ArchiveWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSweepWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSweepWalletJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSweepWalletProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveSweepWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SweepWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SweepWalletRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.SweepWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SweepWalletRequest) (*SweepWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SweepWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SweepWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SweepWalletResponse and nil error while calling SweepWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSweepWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SweepWallet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SweepWalletRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.SweepWallet
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SweepWalletRequest) (*SweepWalletResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepWalletRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepWalletRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SweepWallet(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepWalletResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepWalletResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SweepWalletResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SweepWalletResponse and nil error while calling SweepWallet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSweepAll(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSweepAllJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSweepAllProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveSweepAllJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SweepAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SweepAllRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.SweepAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SweepAllRequest) (*SweepAllResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepAllRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepAllRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SweepAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepAllResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepAllResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SweepAllResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SweepAllResponse and nil error while calling SweepAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSweepAllProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SweepAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SweepAllRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.SweepAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SweepAllRequest) (*SweepAllResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SweepAllRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SweepAllRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SweepAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SweepAllResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SweepAllResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SweepAllResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SweepAllResponse and nil error while calling SweepAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSetTopupRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetTopupRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetTopupRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveSetTopupRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetTopupRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetTopupRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.SetTopupRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTopupRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SetTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetTopupRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetTopupRuleResponse and nil error while calling SetTopupRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSetTopupRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetTopupRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetTopupRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.SetTopupRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetTopupRuleRequest) (*SetTopupRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetTopupRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SetTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetTopupRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetTopupRuleResponse and nil error while calling SetTopupRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveDeleteTopupRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteTopupRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteTopupRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveDeleteTopupRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopupRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteTopupRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.DeleteTopupRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopupRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.DeleteTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteTopupRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteTopupRuleResponse and nil error while calling DeleteTopupRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveDeleteTopupRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopupRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteTopupRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.DeleteTopupRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTopupRuleRequest) (*DeleteTopupRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopupRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopupRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.DeleteTopupRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTopupRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTopupRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteTopupRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteTopupRuleResponse and nil error while calling DeleteTopupRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListTopupRules(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTopupRulesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTopupRulesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListTopupRulesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopupRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListTopupRulesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListTopupRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopupRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopupRulesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListTopupRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopupRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopupRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTopupRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTopupRulesResponse and nil error while calling ListTopupRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListTopupRulesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTopupRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListTopupRulesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListTopupRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTopupRulesRequest) (*ListTopupRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTopupRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTopupRulesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListTopupRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTopupRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTopupRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTopupRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTopupRulesResponse and nil error while calling ListTopupRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListAuditEvents(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAuditEventsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAuditEventsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListAuditEventsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListAuditEventsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListAuditEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAuditEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAuditEventsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListAuditEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAuditEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAuditEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAuditEventsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAuditEventsResponse and nil error while calling ListAuditEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListAuditEventsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListAuditEventsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListAuditEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAuditEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAuditEventsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListAuditEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAuditEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAuditEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAuditEventsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAuditEventsResponse and nil error while calling ListAuditEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCreateInvoice(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateInvoiceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateInvoiceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveCreateInvoiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateInvoice")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateInvoiceRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.CreateInvoice
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateInvoiceRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateInvoiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateInvoiceResponse and nil error while calling CreateInvoice. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveCreateInvoiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateInvoice")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateInvoiceRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.CreateInvoice
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateInvoiceRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.CreateInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateInvoiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateInvoiceResponse and nil error while calling CreateInvoice. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindInvoice(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindInvoiceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindInvoiceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveFindInvoiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindInvoice")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindInvoiceRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.FindInvoice
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindInvoiceRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindInvoiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindInvoiceResponse and nil error while calling FindInvoice. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveFindInvoiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindInvoice")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindInvoiceRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.FindInvoice
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindInvoiceRequest) (*FindInvoiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindInvoiceRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindInvoiceRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.FindInvoice(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindInvoiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindInvoiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindInvoiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindInvoiceResponse and nil error while calling FindInvoice. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListInvoices(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListInvoicesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListInvoicesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListInvoicesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvoices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListInvoicesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListInvoices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvoicesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvoicesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListInvoices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvoicesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvoicesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListInvoicesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListInvoicesResponse and nil error while calling ListInvoices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListInvoicesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListInvoices")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListInvoicesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListInvoices
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListInvoicesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListInvoicesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListInvoices(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListInvoicesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListInvoicesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListInvoicesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListInvoicesResponse and nil error while calling ListInvoices. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSetRouteRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetRouteRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetRouteRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveSetRouteRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRouteRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetRouteRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.SetRouteRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRouteRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SetRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRouteRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRouteRuleResponse and nil error while calling SetRouteRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveSetRouteRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRouteRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetRouteRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.SetRouteRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRouteRuleRequest) (*SetRouteRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRouteRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.SetRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRouteRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRouteRuleResponse and nil error while calling SetRouteRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveDeleteRouteRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteRouteRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteRouteRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveDeleteRouteRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRouteRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteRouteRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.DeleteRouteRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRouteRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.DeleteRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteRouteRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteRouteRuleResponse and nil error while calling DeleteRouteRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveDeleteRouteRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteRouteRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteRouteRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.DeleteRouteRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteRouteRuleRequest) (*DeleteRouteRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteRouteRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteRouteRuleRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.DeleteRouteRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteRouteRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteRouteRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteRouteRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteRouteRuleResponse and nil error while calling DeleteRouteRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListRouteRules(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListRouteRulesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListRouteRulesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListRouteRulesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRouteRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListRouteRulesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListRouteRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRouteRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRouteRulesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListRouteRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRouteRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRouteRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRouteRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRouteRulesResponse and nil error while calling ListRouteRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListRouteRulesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRouteRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListRouteRulesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListRouteRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRouteRulesRequest) (*ListRouteRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRouteRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRouteRulesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListRouteRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRouteRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRouteRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRouteRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRouteRulesResponse and nil error while calling ListRouteRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListDepositRoutes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListDepositRoutesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListDepositRoutesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListDepositRoutesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDepositRoutes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListDepositRoutesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListDepositRoutes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDepositRoutesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDepositRoutesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListDepositRoutes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDepositRoutesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDepositRoutesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDepositRoutesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDepositRoutesResponse and nil error while calling ListDepositRoutes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListDepositRoutesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDepositRoutes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListDepositRoutesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListDepositRoutes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDepositRoutesRequest) (*ListDepositRoutesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDepositRoutesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDepositRoutesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListDepositRoutes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDepositRoutesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDepositRoutesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDepositRoutesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDepositRoutesResponse and nil error while calling ListDepositRoutes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListUnmatchedDeposits(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListUnmatchedDepositsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListUnmatchedDepositsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListUnmatchedDepositsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListUnmatchedDeposits")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListUnmatchedDepositsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListUnmatchedDeposits
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListUnmatchedDepositsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListUnmatchedDepositsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListUnmatchedDeposits(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListUnmatchedDepositsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListUnmatchedDepositsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListUnmatchedDepositsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListUnmatchedDepositsResponse and nil error while calling ListUnmatchedDeposits. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListUnmatchedDepositsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListUnmatchedDeposits")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListUnmatchedDepositsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListUnmatchedDeposits
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListUnmatchedDepositsRequest) (*ListUnmatchedDepositsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListUnmatchedDepositsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListUnmatchedDepositsRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListUnmatchedDeposits(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListUnmatchedDepositsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListUnmatchedDepositsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListUnmatchedDepositsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListUnmatchedDepositsResponse and nil error while calling ListUnmatchedDeposits. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveAssignDeposit(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAssignDepositJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAssignDepositProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveAssignDepositJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AssignDeposit")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AssignDepositRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.AssignDeposit
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AssignDepositRequest) (*AssignDepositResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AssignDepositRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AssignDepositRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.AssignDeposit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AssignDepositResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AssignDepositResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AssignDepositResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AssignDepositResponse and nil error while calling AssignDeposit. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveAssignDepositProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AssignDeposit")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AssignDepositRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.AssignDeposit
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AssignDepositRequest) (*AssignDepositResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AssignDepositRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AssignDepositRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.AssignDeposit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AssignDepositResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AssignDepositResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AssignDepositResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AssignDepositResponse and nil error while calling AssignDeposit. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveInternalTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveInternalTransferJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveInternalTransferProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveInternalTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "InternalTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(InternalTransferRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.InternalTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *InternalTransferRequest) (*InternalTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InternalTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InternalTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.InternalTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InternalTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InternalTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *InternalTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *InternalTransferResponse and nil error while calling InternalTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveInternalTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "InternalTransfer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(InternalTransferRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.InternalTransfer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *InternalTransferRequest) (*InternalTransferResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*InternalTransferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*InternalTransferRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.InternalTransfer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*InternalTransferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*InternalTransferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *InternalTransferResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *InternalTransferResponse and nil error while calling InternalTransfer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveWithdrawAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWithdrawAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWithdrawAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveWithdrawAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WithdrawAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.WithdrawAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawAccountRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.WithdrawAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WithdrawAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WithdrawAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WithdrawAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WithdrawAccountResponse and nil error while calling WithdrawAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveWithdrawAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WithdrawAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WithdrawAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.WithdrawAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WithdrawAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WithdrawAccountRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.WithdrawAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WithdrawAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WithdrawAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WithdrawAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WithdrawAccountResponse and nil error while calling WithdrawAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListAccountBalances(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAccountBalancesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAccountBalancesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListAccountBalancesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccountBalances")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListAccountBalancesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SafeWalletService.ListAccountBalances
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountBalancesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountBalancesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListAccountBalances(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountBalancesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountBalancesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAccountBalancesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAccountBalancesResponse and nil error while calling ListAccountBalances. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListAccountBalancesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccountBalances")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListAccountBalancesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SafeWalletService.ListAccountBalances
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountBalancesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountBalancesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListAccountBalances(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountBalancesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountBalancesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAccountBalancesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAccountBalancesResponse and nil error while calling ListAccountBalances. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListLedgerEntries(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListLedgerEntriesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListLedgerEntriesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *safeWalletServiceServer) serveListLedgerEntriesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListLedgerEntries")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(ListLedgerEntriesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// SafeWalletServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const SafeWalletServicePathPrefix = "/twirp/github.com.pando.safewallet.SafeWalletService/"
+	handler := s.SafeWalletService.ListLedgerEntries
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLedgerEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLedgerEntriesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListLedgerEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLedgerEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLedgerEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *safeWalletServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "github.com.pando.safewallet")
-	ctx = ctxsetters.WithServiceName(ctx, "SafeWalletService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *ListLedgerEntriesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListLedgerEntriesResponse and nil error while calling ListLedgerEntries. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "github.com.pando.safewallet.SafeWalletService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *safeWalletServiceServer) serveListLedgerEntriesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListLedgerEntries")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
 
-	switch method {
-	case "CreateTransfer":
-		s.serveCreateTransfer(ctx, resp, req)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
-	case "FindTransfer":
-		s.serveFindTransfer(ctx, resp, req)
+	}
+	reqContent := new(ListLedgerEntriesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
-	case "CreateWallet":
-		s.serveCreateWallet(ctx, resp, req)
+	}
+
+	handler := s.SafeWalletService.ListLedgerEntries
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLedgerEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLedgerEntriesRequest) when calling interceptor")
+					}
+					return s.SafeWalletService.ListLedgerEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLedgerEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLedgerEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListLedgerEntriesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "FindWallet":
-		s.serveFindWallet(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListLedgerEntriesResponse and nil error while calling ListLedgerEntries. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveCreateTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveCreateEscrow(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -632,9 +10118,9 @@ func (s *safeWalletServiceServer) serveCreateTransfer(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateTransferJSON(ctx, resp, req)
+		s.serveCreateEscrowJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateTransferProtobuf(ctx, resp, req)
+		s.serveCreateEscrowProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -642,9 +10128,9 @@ func (s *safeWalletServiceServer) serveCreateTransfer(ctx context.Context, resp
 	}
 }
 
-func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveCreateEscrowJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -657,29 +10143,29 @@ func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateTransferRequest)
+	reqContent := new(CreateEscrowRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.SafeWalletService.CreateTransfer
+	handler := s.SafeWalletService.CreateEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+		handler = func(ctx context.Context, req *CreateEscrowRequest) (*CreateEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateTransferRequest)
+					typedReq, ok := req.(*CreateEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.CreateTransfer(ctx, typedReq)
+					return s.SafeWalletService.CreateEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateTransferResponse)
+				typedResp, ok := resp.(*CreateEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -688,7 +10174,7 @@ func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *CreateTransferResponse
+	var respContent *CreateEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -699,7 +10185,7 @@ func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTransferResponse and nil error while calling CreateTransfer. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateEscrowResponse and nil error while calling CreateEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -725,9 +10211,9 @@ func (s *safeWalletServiceServer) serveCreateTransferJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveCreateEscrowProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateTransfer")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -739,28 +10225,28 @@ func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateTransferRequest)
+	reqContent := new(CreateEscrowRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.SafeWalletService.CreateTransfer
+	handler := s.SafeWalletService.CreateEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateTransferRequest) (*CreateTransferResponse, error) {
+		handler = func(ctx context.Context, req *CreateEscrowRequest) (*CreateEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateTransferRequest)
+					typedReq, ok := req.(*CreateEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.CreateTransfer(ctx, typedReq)
+					return s.SafeWalletService.CreateEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateTransferResponse)
+				typedResp, ok := resp.(*CreateEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -769,7 +10255,7 @@ func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *CreateTransferResponse
+	var respContent *CreateEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -780,7 +10266,7 @@ func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTransferResponse and nil error while calling CreateTransfer. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateEscrowResponse and nil error while calling CreateEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -804,7 +10290,7 @@ func (s *safeWalletServiceServer) serveCreateTransferProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveFindTransfer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveFindEscrow(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -812,9 +10298,9 @@ func (s *safeWalletServiceServer) serveFindTransfer(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindTransferJSON(ctx, resp, req)
+		s.serveFindEscrowJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindTransferProtobuf(ctx, resp, req)
+		s.serveFindEscrowProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -822,9 +10308,9 @@ func (s *safeWalletServiceServer) serveFindTransfer(ctx context.Context, resp ht
 	}
 }
 
-func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveFindEscrowJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
+	ctx = ctxsetters.WithMethodName(ctx, "FindEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -837,29 +10323,29 @@ func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(FindTransferRequest)
+	reqContent := new(FindEscrowRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.SafeWalletService.FindTransfer
+	handler := s.SafeWalletService.FindEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+		handler = func(ctx context.Context, req *FindEscrowRequest) (*FindEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindTransferRequest)
+					typedReq, ok := req.(*FindEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FindEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.FindTransfer(ctx, typedReq)
+					return s.SafeWalletService.FindEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindTransferResponse)
+				typedResp, ok := resp.(*FindEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FindEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -868,7 +10354,7 @@ func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *FindTransferResponse
+	var respContent *FindEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -879,7 +10365,7 @@ func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindTransferResponse and nil error while calling FindTransfer. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindEscrowResponse and nil error while calling FindEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -905,9 +10391,9 @@ func (s *safeWalletServiceServer) serveFindTransferJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveFindEscrowProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindTransfer")
+	ctx = ctxsetters.WithMethodName(ctx, "FindEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -919,28 +10405,28 @@ func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(FindTransferRequest)
+	reqContent := new(FindEscrowRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.SafeWalletService.FindTransfer
+	handler := s.SafeWalletService.FindEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindTransferRequest) (*FindTransferResponse, error) {
+		handler = func(ctx context.Context, req *FindEscrowRequest) (*FindEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindTransferRequest)
+					typedReq, ok := req.(*FindEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindTransferRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FindEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.FindTransfer(ctx, typedReq)
+					return s.SafeWalletService.FindEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindTransferResponse)
+				typedResp, ok := resp.(*FindEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindTransferResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FindEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -949,7 +10435,7 @@ func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *FindTransferResponse
+	var respContent *FindEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -960,7 +10446,7 @@ func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindTransferResponse and nil error while calling FindTransfer. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindEscrowResponse and nil error while calling FindEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -984,7 +10470,7 @@ func (s *safeWalletServiceServer) serveFindTransferProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveCreateWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveReleaseEscrow(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -992,9 +10478,9 @@ func (s *safeWalletServiceServer) serveCreateWallet(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateWalletJSON(ctx, resp, req)
+		s.serveReleaseEscrowJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateWalletProtobuf(ctx, resp, req)
+		s.serveReleaseEscrowProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1002,9 +10488,9 @@ func (s *safeWalletServiceServer) serveCreateWallet(ctx context.Context, resp ht
 	}
 }
 
-func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveReleaseEscrowJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1017,29 +10503,29 @@ func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateWalletRequest)
+	reqContent := new(ReleaseEscrowRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.SafeWalletService.CreateWallet
+	handler := s.SafeWalletService.ReleaseEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+		handler = func(ctx context.Context, req *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateWalletRequest)
+					typedReq, ok := req.(*ReleaseEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.CreateWallet(ctx, typedReq)
+					return s.SafeWalletService.ReleaseEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateWalletResponse)
+				typedResp, ok := resp.(*ReleaseEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1048,7 +10534,7 @@ func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *CreateWalletResponse
+	var respContent *ReleaseEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1059,7 +10545,7 @@ func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateWalletResponse and nil error while calling CreateWallet. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseEscrowResponse and nil error while calling ReleaseEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -1085,9 +10571,9 @@ func (s *safeWalletServiceServer) serveCreateWalletJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveReleaseEscrowProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateWallet")
+	ctx = ctxsetters.WithMethodName(ctx, "ReleaseEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1099,28 +10585,28 @@ func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateWalletRequest)
+	reqContent := new(ReleaseEscrowRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.SafeWalletService.CreateWallet
+	handler := s.SafeWalletService.ReleaseEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+		handler = func(ctx context.Context, req *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateWalletRequest)
+					typedReq, ok := req.(*ReleaseEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReleaseEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.CreateWallet(ctx, typedReq)
+					return s.SafeWalletService.ReleaseEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateWalletResponse)
+				typedResp, ok := resp.(*ReleaseEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReleaseEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1129,7 +10615,7 @@ func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *CreateWalletResponse
+	var respContent *ReleaseEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1140,7 +10626,7 @@ func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateWalletResponse and nil error while calling CreateWallet. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReleaseEscrowResponse and nil error while calling ReleaseEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -1164,7 +10650,7 @@ func (s *safeWalletServiceServer) serveCreateWalletProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveFindWallet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveRefundEscrow(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1172,9 +10658,9 @@ func (s *safeWalletServiceServer) serveFindWallet(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindWalletJSON(ctx, resp, req)
+		s.serveRefundEscrowJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindWalletProtobuf(ctx, resp, req)
+		s.serveRefundEscrowProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1182,9 +10668,9 @@ func (s *safeWalletServiceServer) serveFindWallet(ctx context.Context, resp http
 	}
 }
 
-func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveRefundEscrowJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1197,29 +10683,29 @@ func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(FindWalletRequest)
+	reqContent := new(RefundEscrowRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.SafeWalletService.FindWallet
+	handler := s.SafeWalletService.RefundEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+		handler = func(ctx context.Context, req *RefundEscrowRequest) (*RefundEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindWalletRequest)
+					typedReq, ok := req.(*RefundEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RefundEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.FindWallet(ctx, typedReq)
+					return s.SafeWalletService.RefundEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindWalletResponse)
+				typedResp, ok := resp.(*RefundEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1228,7 +10714,7 @@ func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *FindWalletResponse
+	var respContent *RefundEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1239,7 +10725,7 @@ func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletResponse and nil error while calling FindWallet. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundEscrowResponse and nil error while calling RefundEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -1265,9 +10751,9 @@ func (s *safeWalletServiceServer) serveFindWalletJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *safeWalletServiceServer) serveFindWalletProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *safeWalletServiceServer) serveRefundEscrowProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindWallet")
+	ctx = ctxsetters.WithMethodName(ctx, "RefundEscrow")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1279,28 +10765,28 @@ func (s *safeWalletServiceServer) serveFindWalletProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(FindWalletRequest)
+	reqContent := new(RefundEscrowRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.SafeWalletService.FindWallet
+	handler := s.SafeWalletService.RefundEscrow
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *FindWalletRequest) (*FindWalletResponse, error) {
+		handler = func(ctx context.Context, req *RefundEscrowRequest) (*RefundEscrowResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*FindWalletRequest)
+					typedReq, ok := req.(*RefundEscrowRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*FindWalletRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RefundEscrowRequest) when calling interceptor")
 					}
-					return s.SafeWalletService.FindWallet(ctx, typedReq)
+					return s.SafeWalletService.RefundEscrow(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindWalletResponse)
+				typedResp, ok := resp.(*RefundEscrowResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindWalletResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RefundEscrowResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1309,7 +10795,7 @@ func (s *safeWalletServiceServer) serveFindWalletProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *FindWalletResponse
+	var respContent *RefundEscrowResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1320,7 +10806,7 @@ func (s *safeWalletServiceServer) serveFindWalletProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindWalletResponse and nil error while calling FindWallet. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefundEscrowResponse and nil error while calling RefundEscrow. nil responses are not supported"))
 		return
 	}
 
@@ -1925,45 +11411,231 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x5d, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0x3f, 0xc7, 0x6d, 0x7e, 0x4e, 0xfa, 0x45, 0xe9, 0xb4, 0x14, 0x13, 0x90, 0x88, 0x2c,
-	0x90, 0x2c, 0x51, 0x39, 0xc5, 0xbd, 0x42, 0xe2, 0x82, 0x14, 0x87, 0x12, 0x09, 0x05, 0x64, 0x1b,
-	0x10, 0x70, 0x11, 0x4d, 0xec, 0x49, 0x1b, 0xc9, 0xf6, 0x18, 0xcf, 0x18, 0xc4, 0x0e, 0x58, 0x1d,
-	0xdb, 0x60, 0x1b, 0xc8, 0x63, 0xa7, 0xb6, 0x51, 0xe2, 0xb6, 0x12, 0x77, 0x39, 0x33, 0xe7, 0xe7,
-	0x39, 0xaf, 0xdf, 0x68, 0xe0, 0x28, 0x8e, 0xdc, 0x51, 0x14, 0x53, 0x4e, 0x47, 0xdf, 0xb1, 0xef,
-	0x13, 0xae, 0x8b, 0x00, 0xdd, 0xbf, 0x58, 0xf1, 0xcb, 0x64, 0xa1, 0xbb, 0x34, 0xd0, 0x23, 0x1c,
-	0x7a, 0x54, 0x67, 0x78, 0x49, 0xb2, 0x94, 0xc1, 0xc3, 0x0b, 0x4a, 0x2f, 0x7c, 0x92, 0xd5, 0x2d,
-	0x92, 0xe5, 0x88, 0xaf, 0x02, 0xc2, 0x38, 0x0e, 0xa2, 0xac, 0x5a, 0xfd, 0x29, 0x43, 0xdb, 0x89,
-	0x71, 0xc8, 0x96, 0x24, 0x46, 0xf7, 0xa0, 0xcd, 0x63, 0xec, 0x92, 0xf9, 0xca, 0x53, 0xa4, 0xa1,
-	0xa4, 0x75, 0xac, 0x96, 0x88, 0xa7, 0x1e, 0x7a, 0x06, 0xe0, 0xc6, 0x04, 0x73, 0xe2, 0xcd, 0x31,
-	0x57, 0x1a, 0x43, 0x49, 0xeb, 0x1a, 0x03, 0x3d, 0xeb, 0xae, 0xaf, 0xbb, 0xeb, 0xce, 0xba, 0xbb,
-	0xd5, 0xc9, 0xb3, 0xc7, 0x1c, 0x99, 0xd0, 0x64, 0x1c, 0xf3, 0x84, 0x29, 0xf2, 0x50, 0xd2, 0x7a,
-	0xc6, 0xb1, 0x5e, 0x43, 0xac, 0xaf, 0x61, 0x74, 0x5b, 0xd4, 0x58, 0x79, 0x6d, 0xca, 0x86, 0x19,
-	0x23, 0x3c, 0x65, 0xdb, 0xc9, 0xd8, 0x44, 0x3c, 0xf5, 0xd0, 0x11, 0x34, 0x71, 0x40, 0x93, 0x90,
-	0x2b, 0xbb, 0xe2, 0x22, 0x8f, 0x10, 0x82, 0x9d, 0x80, 0x04, 0x54, 0x69, 0x8a, 0x53, 0xf1, 0x1b,
-	0x3d, 0x80, 0x0e, 0x8d, 0x22, 0x1a, 0x92, 0x90, 0x33, 0xa5, 0x35, 0x94, 0xb5, 0x8e, 0x55, 0x1c,
-	0xa4, 0xb7, 0xfc, 0x32, 0x26, 0xec, 0x92, 0xfa, 0x9e, 0xd2, 0x1e, 0x4a, 0xda, 0xff, 0x56, 0x71,
-	0x80, 0xee, 0x42, 0x2b, 0x61, 0x24, 0x4e, 0x09, 0x3a, 0xd9, 0xa0, 0x34, 0x9c, 0x7a, 0xaa, 0x09,
-	0xcd, 0x8c, 0x16, 0x21, 0xe8, 0xd9, 0xce, 0xd8, 0x79, 0x6f, 0xcf, 0x67, 0x6f, 0x9d, 0xb9, 0x3d,
-	0x71, 0xfa, 0xff, 0xa1, 0x2e, 0xb4, 0xde, 0x4d, 0x66, 0xe6, 0x74, 0x76, 0xde, 0x97, 0xd0, 0x1e,
-	0xb4, 0xc7, 0xb6, 0x3d, 0x3d, 0x9f, 0x4d, 0xcc, 0x7e, 0x23, 0xbd, 0x7a, 0x3d, 0x9e, 0x99, 0x6f,
-	0x26, 0x66, 0x5f, 0x56, 0x7f, 0x49, 0x70, 0xe7, 0xa5, 0x50, 0x6d, 0xad, 0x81, 0x45, 0xbe, 0x26,
-	0x84, 0xf1, 0xba, 0xef, 0x52, 0x96, 0xa5, 0xb1, 0x4d, 0x16, 0x79, 0xa3, 0x2c, 0x3b, 0xdb, 0x64,
-	0xd9, 0xad, 0x95, 0xa5, 0x59, 0x23, 0x4b, 0xab, 0x22, 0xcb, 0x17, 0x38, 0xfa, 0x7b, 0x1f, 0x16,
-	0xd1, 0x90, 0x11, 0x34, 0x16, 0x0b, 0x89, 0x33, 0xb1, 0x50, 0xd7, 0x78, 0x7c, 0x23, 0x53, 0x58,
-	0x57, 0x65, 0xea, 0x09, 0x1c, 0xbc, 0x5a, 0x85, 0xde, 0xcd, 0xa5, 0x52, 0x3f, 0xc1, 0x61, 0xb5,
-	0xe2, 0xdf, 0xc1, 0x3c, 0x81, 0x83, 0x6c, 0xd3, 0x8f, 0x22, 0x65, 0x0d, 0x73, 0x08, 0xbb, 0x3e,
-	0x5e, 0x10, 0x3f, 0x27, 0xc9, 0x02, 0x75, 0x02, 0x87, 0xd5, 0xe4, 0x9c, 0xa3, 0xa4, 0xa3, 0x54,
-	0xd6, 0xb1, 0x68, 0xd3, 0x28, 0xb7, 0x79, 0x0e, 0xad, 0x33, 0xec, 0xe3, 0xd0, 0x25, 0x15, 0x13,
-	0x48, 0xdb, 0x4c, 0xd0, 0x28, 0x9b, 0x40, 0x3d, 0x86, 0xfd, 0x54, 0x8c, 0x2a, 0xef, 0x36, 0x02,
-	0xf5, 0x03, 0xa0, 0x72, 0x76, 0x0e, 0xfc, 0x02, 0xda, 0x8b, 0x8c, 0x80, 0x29, 0xd2, 0x50, 0xd6,
-	0xba, 0xc6, 0xa3, 0x5a, 0xe1, 0x72, 0x5c, 0xeb, 0xaa, 0xca, 0xf8, 0x2d, 0xc3, 0xbe, 0x8d, 0x97,
-	0xb9, 0x12, 0x36, 0x89, 0xbf, 0xad, 0x5c, 0x82, 0x7e, 0x40, 0xaf, 0xea, 0x1b, 0x64, 0xd4, 0xf6,
-	0xdd, 0xf8, 0xa7, 0x19, 0x9c, 0xde, 0xaa, 0x26, 0x5f, 0x89, 0xc1, 0x5e, 0xd9, 0x23, 0xe8, 0xa4,
-	0xb6, 0xc9, 0x06, 0x03, 0x0e, 0x9e, 0xde, 0xa2, 0xa2, 0x18, 0x5a, 0x36, 0xc4, 0x35, 0x43, 0x37,
-	0x18, 0xed, 0x9a, 0xa1, 0x1b, 0xdd, 0x16, 0x00, 0x14, 0x9f, 0x14, 0xe9, 0xd7, 0x52, 0x57, 0x07,
-	0x8e, 0x6e, 0x9c, 0x9f, 0x8d, 0x3b, 0xeb, 0x7f, 0xee, 0xa5, 0xef, 0x57, 0x91, 0xb4, 0x68, 0x8a,
-	0x57, 0xe3, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x7b, 0xfa, 0x99, 0xd8, 0x06, 0x00,
-	0x00,
+	// 3614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xdf, 0xf0, 0xcd, 0x43, 0x49, 0xa6, 0xaf, 0x1e, 0xa6, 0xc7, 0xf1, 0x17, 0x65, 0x3e, 0x7f,
+	0x5f, 0x94, 0x2f, 0x8e, 0x6c, 0xc9, 0x4a, 0x5a, 0xe7, 0x4d, 0x99, 0x74, 0x44, 0x58, 0xa0, 0x94,
+	0x21, 0x65, 0xc7, 0x69, 0x52, 0x62, 0x24, 0x5e, 0x49, 0x83, 0x50, 0x33, 0xec, 0xcc, 0xd0, 0x16,
+	0x9b, 0x16, 0x49, 0xd3, 0x45, 0xbb, 0x68, 0x03, 0x14, 0x05, 0xd2, 0x55, 0x0b, 0x64, 0x51, 0x74,
+	0xdb, 0xee, 0xda, 0x7d, 0x37, 0xdd, 0x74, 0x19, 0xa0, 0xfd, 0x07, 0x45, 0x57, 0xfd, 0x07, 0xc5,
+	0x7d, 0xcc, 0x53, 0xc3, 0x79, 0xc8, 0xac, 0x1b, 0x74, 0x65, 0xde, 0x7b, 0xe7, 0x9c, 0x7b, 0xde,
+	0xf7, 0xdc, 0x73, 0x8f, 0x0c, 0x4b, 0xc6, 0xf0, 0xe0, 0xc6, 0xd0, 0xd0, 0x2d, 0xfd, 0xc6, 0x63,
+	0x65, 0x30, 0xc0, 0xd6, 0x2a, 0x1d, 0xa0, 0x2b, 0x47, 0xaa, 0x75, 0x3c, 0xda, 0x5f, 0x3d, 0xd0,
+	0x4f, 0x56, 0x87, 0x8a, 0xd6, 0xd7, 0x57, 0x4d, 0xe5, 0x10, 0xb3, 0x4f, 0xc4, 0x67, 0x8f, 0x74,
+	0xfd, 0x68, 0x80, 0x19, 0xdc, 0xfe, 0xe8, 0xf0, 0x86, 0xa5, 0x9e, 0x60, 0xd3, 0x52, 0x4e, 0x86,
+	0x0c, 0x5a, 0xfa, 0x43, 0x1e, 0x4a, 0x5d, 0x43, 0xd1, 0xcc, 0x43, 0x6c, 0xa0, 0xcb, 0x50, 0xb2,
+	0x0c, 0xe5, 0x00, 0xf7, 0xd4, 0x7e, 0x4d, 0x58, 0x16, 0x56, 0xca, 0x72, 0x91, 0x8e, 0x5b, 0x7d,
+	0x74, 0x1b, 0xe0, 0xc0, 0xc0, 0x8a, 0x85, 0xfb, 0x3d, 0xc5, 0xaa, 0x65, 0x96, 0x85, 0x95, 0xca,
+	0xba, 0xb8, 0xca, 0xb0, 0xaf, 0xda, 0xd8, 0x57, 0xbb, 0x36, 0x76, 0xb9, 0xcc, 0xbf, 0xae, 0x5b,
+	0xa8, 0x01, 0x05, 0xd3, 0x52, 0xac, 0x91, 0x59, 0xcb, 0x2e, 0x0b, 0x2b, 0x73, 0xeb, 0xd7, 0x57,
+	0x23, 0x28, 0x5e, 0xb5, 0x89, 0x59, 0xed, 0x50, 0x18, 0x99, 0xc3, 0x12, 0xda, 0x14, 0xd3, 0xc4,
+	0x16, 0xa1, 0x2d, 0xc7, 0x68, 0xa3, 0xe3, 0x56, 0x1f, 0x2d, 0x41, 0x41, 0x39, 0xd1, 0x47, 0x9a,
+	0x55, 0xcb, 0xd3, 0x05, 0x3e, 0x42, 0x08, 0x72, 0x27, 0xf8, 0x44, 0xaf, 0x15, 0xe8, 0x2c, 0xfd,
+	0x8d, 0x9e, 0x81, 0xb2, 0x3e, 0x1c, 0xea, 0x1a, 0xd6, 0x2c, 0xb3, 0x56, 0x5c, 0xce, 0xae, 0x94,
+	0x65, 0x77, 0x82, 0xac, 0x5a, 0xc7, 0x06, 0x36, 0x8f, 0xf5, 0x41, 0xbf, 0x56, 0x5a, 0x16, 0x56,
+	0x66, 0x65, 0x77, 0x02, 0x5d, 0x82, 0xe2, 0xc8, 0xc4, 0x06, 0xa1, 0xa0, 0xcc, 0x36, 0x22, 0xc3,
+	0x56, 0x1f, 0xbd, 0x09, 0xb9, 0x8f, 0x54, 0xad, 0x5f, 0x03, 0xca, 0xdf, 0xff, 0x27, 0xe3, 0xef,
+	0x9e, 0xaa, 0xf5, 0x65, 0x0a, 0x47, 0x10, 0x5b, 0xa7, 0xbd, 0x63, 0xc5, 0x3c, 0xae, 0x55, 0x18,
+	0x62, 0xeb, 0x74, 0x4b, 0x31, 0x8f, 0xd1, 0xf3, 0x70, 0x41, 0x1f, 0x59, 0xc3, 0x91, 0xd5, 0x33,
+	0xf1, 0x77, 0x46, 0x58, 0x3b, 0xc0, 0xb5, 0x99, 0x65, 0x61, 0x25, 0x27, 0xcf, 0xb1, 0xe9, 0x0e,
+	0x9f, 0x45, 0x57, 0xa0, 0x6c, 0xe0, 0xc3, 0x91, 0xd6, 0xef, 0xe9, 0x87, 0xb5, 0x59, 0xfa, 0x49,
+	0x89, 0x4d, 0xec, 0x1c, 0xa2, 0x4d, 0xb8, 0x40, 0xe8, 0xef, 0xe1, 0xd3, 0xa1, 0x6a, 0x60, 0x93,
+	0x28, 0x70, 0x2e, 0x56, 0x81, 0xb3, 0x04, 0xa4, 0xc9, 0x20, 0xea, 0x96, 0xf4, 0x01, 0x14, 0x98,
+	0x42, 0x10, 0x82, 0xb9, 0x4e, 0xb7, 0xde, 0xdd, 0xeb, 0xf4, 0xda, 0x3b, 0xdd, 0x5e, 0xa7, 0xd9,
+	0xad, 0xfe, 0x17, 0xaa, 0x40, 0x71, 0xb7, 0xd9, 0x6e, 0xb4, 0xda, 0xef, 0x54, 0x05, 0x34, 0x03,
+	0xa5, 0x7a, 0xa7, 0xd3, 0x7a, 0xa7, 0xdd, 0x6c, 0x54, 0x33, 0x64, 0x69, 0xab, 0xde, 0x6e, 0x6c,
+	0x37, 0x1b, 0xd5, 0x2c, 0x2a, 0x41, 0x6e, 0xab, 0xb9, 0xdd, 0xa8, 0xe6, 0xc8, 0x47, 0x72, 0x73,
+	0xbb, 0x59, 0xef, 0x34, 0x1b, 0xd5, 0xbc, 0xb4, 0x01, 0x39, 0x22, 0x0e, 0x54, 0x85, 0x99, 0x7b,
+	0xad, 0x76, 0xc3, 0x83, 0x79, 0x06, 0x4a, 0xcd, 0xf7, 0xba, 0x4d, 0xb9, 0x5d, 0xdf, 0x66, 0xa8,
+	0x5b, 0x6d, 0x3e, 0xca, 0x48, 0x7f, 0x16, 0x60, 0xf1, 0x0e, 0x35, 0x33, 0x5b, 0xa8, 0x32, 0x91,
+	0x87, 0x69, 0x45, 0x19, 0xb2, 0xd7, 0x8e, 0x32, 0x93, 0xec, 0x28, 0x1b, 0x6a, 0x47, 0xb9, 0x49,
+	0x76, 0x94, 0x8f, 0xb4, 0xa3, 0x42, 0x84, 0x1d, 0x15, 0xbd, 0x76, 0x24, 0x7d, 0x0b, 0x96, 0x82,
+	0xfc, 0x98, 0x43, 0x5d, 0x33, 0x31, 0xaa, 0x53, 0x86, 0xe8, 0x1c, 0x65, 0xa8, 0xb2, 0xfe, 0xbf,
+	0x89, 0xac, 0x4c, 0x76, 0xc0, 0xa4, 0x9b, 0x30, 0x7f, 0x57, 0xd5, 0xfa, 0xc9, 0x45, 0x25, 0x3d,
+	0x84, 0x05, 0x3f, 0xc4, 0xf4, 0x88, 0xf9, 0x53, 0x06, 0x0a, 0x0f, 0xe8, 0xaa, 0x57, 0x1a, 0x82,
+	0xcf, 0xab, 0x9e, 0x20, 0xe4, 0x6c, 0x06, 0x42, 0x4e, 0xb4, 0x4b, 0x32, 0x42, 0x82, 0x01, 0x67,
+	0x01, 0xf2, 0x03, 0x65, 0x1f, 0x0f, 0xb8, 0xda, 0xd9, 0x00, 0x3d, 0x0b, 0x15, 0x7c, 0x6a, 0x61,
+	0x43, 0x53, 0x06, 0x84, 0x62, 0x16, 0x70, 0xc0, 0x9e, 0x6a, 0xf5, 0x91, 0x08, 0xa5, 0x13, 0x6c,
+	0x29, 0x7d, 0xc5, 0x52, 0x78, 0xe0, 0x71, 0xc6, 0x52, 0x37, 0xd2, 0x89, 0xaa, 0x30, 0xb3, 0x2b,
+	0xef, 0xdc, 0x6f, 0x75, 0x5a, 0x3b, 0x6d, 0xe6, 0x49, 0x00, 0x85, 0xfa, 0x9d, 0x6e, 0xeb, 0x7e,
+	0xb3, 0x9a, 0x21, 0xbf, 0xef, 0xca, 0x3b, 0xef, 0x37, 0xdb, 0xd5, 0x2c, 0xf5, 0x30, 0xf9, 0xce,
+	0x56, 0xeb, 0x7e, 0xb3, 0x51, 0xcd, 0x49, 0x3f, 0x12, 0x60, 0x9e, 0x99, 0x0d, 0x63, 0xc4, 0xd6,
+	0xac, 0xc3, 0x80, 0xe0, 0x65, 0xe0, 0x2a, 0x80, 0xc1, 0x3e, 0x70, 0x3d, 0xa0, 0xcc, 0x67, 0x5a,
+	0xfd, 0x20, 0x7f, 0xd9, 0x48, 0xfe, 0x72, 0x01, 0xfe, 0x3e, 0x13, 0x60, 0xc1, 0x4f, 0x09, 0xb7,
+	0x98, 0x89, 0x3a, 0x76, 0x68, 0xcc, 0x78, 0x69, 0x7c, 0x0d, 0x0a, 0x4c, 0x35, 0x74, 0xff, 0xca,
+	0xfa, 0xff, 0x24, 0x50, 0x9f, 0xcc, 0x41, 0xa4, 0xd7, 0xa1, 0xb8, 0xa9, 0x0c, 0x14, 0x12, 0x15,
+	0xbd, 0xbe, 0x2e, 0x4c, 0xf2, 0xf5, 0x8c, 0xd7, 0xd7, 0xa5, 0xeb, 0x70, 0x91, 0xd8, 0xbc, 0x5f,
+	0x92, 0x93, 0xc8, 0x97, 0xee, 0x03, 0xf2, 0x7e, 0xcd, 0xb9, 0x7d, 0x1b, 0x4a, 0xfb, 0x8c, 0x02,
+	0xb3, 0x26, 0x2c, 0x67, 0x57, 0x2a, 0xeb, 0xd7, 0x22, 0x19, 0xe0, 0xe4, 0xca, 0x0e, 0x94, 0xf4,
+	0x36, 0x5c, 0x75, 0xf1, 0x6e, 0x8e, 0x9b, 0xb6, 0xf8, 0x1b, 0x36, 0x45, 0x01, 0x35, 0x09, 0x41,
+	0x35, 0x49, 0x1f, 0xc2, 0x7f, 0x4f, 0xc2, 0xc0, 0xa9, 0x74, 0x85, 0x2c, 0xa4, 0x17, 0xf2, 0x5f,
+	0x04, 0x40, 0xdb, 0xaa, 0x69, 0xb1, 0x69, 0xd3, 0x26, 0x6b, 0x09, 0x0a, 0xfa, 0xe1, 0xa1, 0xc9,
+	0x71, 0xe6, 0x64, 0x3e, 0xa2, 0x6a, 0x56, 0x4f, 0x54, 0x26, 0xec, 0x59, 0x99, 0x0d, 0x5c, 0xe5,
+	0x67, 0x23, 0x3c, 0x2c, 0x17, 0x69, 0x81, 0x79, 0xbf, 0x05, 0x7a, 0x1c, 0xbf, 0x70, 0x5e, 0xc7,
+	0x97, 0x46, 0x30, 0xef, 0x63, 0x8d, 0xcb, 0xeb, 0x0d, 0x28, 0x32, 0x30, 0x5b, 0xa9, 0x89, 0x04,
+	0x66, 0xc3, 0x10, 0xb6, 0x34, 0x7c, 0x6a, 0xf5, 0xb8, 0x7c, 0x32, 0x54, 0x3e, 0x40, 0xa6, 0x76,
+	0xe8, 0x8c, 0x74, 0x17, 0xe6, 0xef, 0x1a, 0x18, 0x7f, 0x17, 0x27, 0xb3, 0x3d, 0x22, 0x6b, 0x03,
+	0x2b, 0xa6, 0xae, 0xd9, 0xa7, 0x15, 0x1b, 0x49, 0x1d, 0x58, 0xf0, 0xe3, 0x99, 0x86, 0xbe, 0xb7,
+	0x60, 0x71, 0x4f, 0x3b, 0x9c, 0x06, 0x79, 0x7b, 0xb0, 0x14, 0xc4, 0x34, 0x0d, 0x02, 0x3f, 0x84,
+	0x85, 0xba, 0x71, 0x70, 0xac, 0x3e, 0x7a, 0x42, 0xfa, 0x88, 0x51, 0x9a, 0x8f, 0x31, 0x1e, 0x52,
+	0xc3, 0x2b, 0xc9, 0x6c, 0x20, 0xfd, 0x5c, 0x80, 0xc5, 0x00, 0xfe, 0x29, 0x50, 0x8d, 0xde, 0x80,
+	0x02, 0xc5, 0x6f, 0xd6, 0x32, 0xcb, 0xd9, 0xe4, 0xe7, 0x28, 0x07, 0x92, 0xb6, 0x00, 0x75, 0xc8,
+	0xaf, 0x84, 0x2c, 0x4f, 0x4e, 0x7d, 0xa4, 0xf7, 0x61, 0xde, 0x87, 0x89, 0x33, 0x77, 0x07, 0xca,
+	0xf6, 0x91, 0x6d, 0x5b, 0x7d, 0x42, 0x12, 0x5d, 0x38, 0xe9, 0x3a, 0x5c, 0xa0, 0xb8, 0xeb, 0x83,
+	0x81, 0x27, 0xe9, 0x98, 0x10, 0x98, 0xa5, 0x07, 0x50, 0x75, 0xbf, 0x9e, 0x26, 0x19, 0xbf, 0x17,
+	0x60, 0x81, 0xf8, 0xb5, 0xbd, 0x96, 0x2e, 0x68, 0xe5, 0xed, 0xa0, 0xe5, 0x91, 0x6e, 0x76, 0xa2,
+	0x74, 0x03, 0x17, 0x14, 0xfb, 0x7e, 0x90, 0x3f, 0xdf, 0xfd, 0x40, 0xfa, 0x3e, 0x2c, 0x06, 0x28,
+	0x9f, 0xa2, 0x60, 0xe2, 0x23, 0xd3, 0x23, 0x98, 0x97, 0xd9, 0x5d, 0x82, 0x5e, 0x3a, 0x6c, 0xb9,
+	0x89, 0x50, 0x72, 0x6e, 0x25, 0x4c, 0x72, 0xce, 0x78, 0xd2, 0xf1, 0xea, 0xa4, 0xd2, 0x59, 0x4f,
+	0x2a, 0xed, 0x91, 0x68, 0xce, 0x77, 0xba, 0x3e, 0x84, 0x05, 0xff, 0xbe, 0xd3, 0xcb, 0x3f, 0xff,
+	0x2e, 0xc0, 0xfc, 0x96, 0x3e, 0xe8, 0xff, 0x67, 0x5c, 0x1c, 0x48, 0x52, 0x67, 0x5f, 0xee, 0x54,
+	0x8d, 0x5e, 0x5c, 0xb3, 0x72, 0x99, 0xcf, 0xb4, 0x34, 0x22, 0x48, 0x3f, 0xb3, 0xd3, 0x13, 0xe4,
+	0x3d, 0x58, 0xba, 0xa3, 0x0c, 0xad, 0x91, 0x91, 0xe6, 0x0e, 0x36, 0x29, 0xf9, 0xfa, 0x00, 0x2e,
+	0x9d, 0x41, 0x36, 0x3d, 0x52, 0x6f, 0xc1, 0x92, 0x8c, 0x07, 0x58, 0x31, 0x53, 0x90, 0x2a, 0xfd,
+	0x52, 0x80, 0x4b, 0x67, 0xa0, 0xa6, 0x46, 0x13, 0x7a, 0x0b, 0x8a, 0x06, 0xc3, 0x5e, 0xcb, 0xa4,
+	0xc1, 0x60, 0x43, 0x49, 0xbf, 0xca, 0x41, 0x81, 0xb9, 0x47, 0xa4, 0x3f, 0x3e, 0xc1, 0x5d, 0x0a,
+	0x41, 0x8e, 0x56, 0x26, 0xb8, 0xcb, 0x92, 0xdf, 0x24, 0x34, 0xaa, 0x5a, 0x1f, 0x9f, 0x52, 0xcb,
+	0x9e, 0x95, 0xd9, 0xc0, 0x6b, 0x9e, 0xf9, 0x89, 0xa1, 0xb1, 0x30, 0xc9, 0x75, 0x8a, 0x3e, 0xd7,
+	0x79, 0x0b, 0xf2, 0xa6, 0xa5, 0x58, 0x98, 0x1a, 0xf3, 0xdc, 0xfa, 0x0b, 0x91, 0x62, 0x61, 0x02,
+	0xa0, 0x79, 0x1c, 0x96, 0x19, 0x1c, 0xd9, 0xd3, 0x1c, 0x62, 0xcd, 0xea, 0xed, 0x8f, 0x79, 0xb5,
+	0xa6, 0x48, 0xc7, 0x9b, 0x63, 0x54, 0x83, 0xa2, 0x89, 0xb5, 0x3e, 0x89, 0x99, 0x40, 0x1d, 0xd0,
+	0x1e, 0xa2, 0x17, 0xe1, 0x22, 0xff, 0xd9, 0x73, 0xdd, 0xb0, 0x42, 0x79, 0xac, 0xf2, 0x85, 0xae,
+	0x3d, 0x4f, 0x84, 0x80, 0x4f, 0x2d, 0x43, 0xa1, 0x25, 0x99, 0xb2, 0xcc, 0x06, 0x8e, 0xcf, 0xcf,
+	0x7a, 0x7c, 0xfe, 0x05, 0xa8, 0xaa, 0x9a, 0x79, 0x60, 0xa8, 0x43, 0x4b, 0xd5, 0x35, 0x56, 0xe8,
+	0x99, 0xa3, 0xeb, 0x17, 0x3c, 0xf3, 0xa4, 0xe2, 0x23, 0xed, 0x40, 0x9e, 0xb2, 0x81, 0x2e, 0xc2,
+	0x2c, 0xb9, 0x21, 0x36, 0xfd, 0x55, 0x96, 0xbd, 0x76, 0x67, 0xb7, 0xd9, 0xee, 0xb2, 0xbb, 0xa1,
+	0x53, 0x63, 0x29, 0x43, 0x9e, 0x4d, 0x67, 0xd1, 0x05, 0xa8, 0xbc, 0xbb, 0x57, 0x97, 0xeb, 0xed,
+	0x6e, 0xab, 0x4d, 0x6f, 0x87, 0x7f, 0xe5, 0x99, 0x3a, 0x93, 0xd1, 0x53, 0x3c, 0xf4, 0x1c, 0x0d,
+	0xe6, 0xcf, 0xa9, 0xc1, 0xe7, 0x60, 0xa6, 0x8f, 0x87, 0xba, 0xa9, 0x5a, 0x3d, 0x5d, 0x1b, 0x8c,
+	0xa9, 0xe5, 0x94, 0xe4, 0x0a, 0x9f, 0xdb, 0xd1, 0x06, 0x63, 0x3b, 0x55, 0x77, 0x78, 0x73, 0x53,
+	0x75, 0x56, 0x1f, 0x4b, 0x96, 0xaa, 0x33, 0x70, 0xd9, 0x86, 0x89, 0x3f, 0x10, 0xff, 0x28, 0x40,
+	0xb9, 0xab, 0x0f, 0x47, 0x43, 0x79, 0x34, 0xc0, 0xe7, 0xc9, 0xb7, 0x88, 0x98, 0x0f, 0x07, 0xba,
+	0x6e, 0xd8, 0x57, 0x1f, 0x3a, 0x20, 0x4a, 0xb1, 0x14, 0xe3, 0x08, 0x5b, 0xf6, 0x41, 0xc8, 0x46,
+	0xc4, 0x60, 0x0d, 0x7c, 0xa8, 0x0e, 0x06, 0x26, 0x15, 0x66, 0x4e, 0xb6, 0x87, 0xe8, 0x35, 0xa8,
+	0xb0, 0x9f, 0xcc, 0xb1, 0x0b, 0xb1, 0x8e, 0x0d, 0xf6, 0xe7, 0x75, 0x4b, 0x7a, 0x0c, 0xf3, 0x1d,
+	0x6c, 0x39, 0x8c, 0x3c, 0x41, 0xfe, 0x98, 0x8e, 0x1f, 0x49, 0x86, 0x05, 0xff, 0xc6, 0x5c, 0x6f,
+	0xaf, 0x42, 0xce, 0x18, 0x0d, 0x30, 0x0f, 0xa6, 0xff, 0x17, 0x1d, 0x0a, 0x1d, 0x68, 0x0a, 0x23,
+	0x6d, 0xc3, 0x52, 0x03, 0x0f, 0xb0, 0x85, 0xa7, 0xc1, 0x8f, 0x74, 0x19, 0x2e, 0x9d, 0xc1, 0xc6,
+	0x88, 0x94, 0x6e, 0xf2, 0x64, 0xcc, 0x5e, 0x30, 0x13, 0x54, 0x09, 0x96, 0x82, 0x10, 0x9c, 0xe1,
+	0xd7, 0x21, 0x4f, 0x88, 0xb7, 0xcd, 0x34, 0x29, 0xc7, 0x0c, 0x48, 0xfa, 0x34, 0x03, 0x50, 0x1f,
+	0xf5, 0x55, 0xab, 0xf9, 0x08, 0x6b, 0x16, 0x9a, 0x83, 0x0c, 0xdf, 0x3a, 0x27, 0x67, 0xd4, 0x27,
+	0xaa, 0x9f, 0x2d, 0x40, 0x5e, 0x39, 0xb0, 0x5c, 0x75, 0xd2, 0x01, 0x8d, 0xd5, 0x07, 0x24, 0x52,
+	0xd9, 0xea, 0x64, 0x23, 0x8f, 0x9a, 0xf3, 0x3e, 0xb3, 0x5d, 0x82, 0xc2, 0x50, 0x31, 0x94, 0x13,
+	0x93, 0x07, 0x7d, 0x3e, 0x22, 0xe6, 0xac, 0x8f, 0xac, 0x03, 0xfd, 0x04, 0xf3, 0xa0, 0x6f, 0x0f,
+	0x49, 0x19, 0x7b, 0x68, 0xe0, 0x47, 0x2c, 0x42, 0x96, 0xe8, 0x5a, 0x89, 0x4c, 0xd0, 0x62, 0xb8,
+	0x7d, 0x10, 0x95, 0xdd, 0x83, 0x48, 0xb2, 0x98, 0x68, 0x5d, 0x29, 0x9c, 0x33, 0xc0, 0xa5, 0x62,
+	0x58, 0xfa, 0x18, 0x2e, 0x9d, 0xd9, 0x95, 0x6b, 0xf4, 0x2d, 0x28, 0x60, 0x3a, 0xc3, 0x55, 0xfa,
+	0x7c, 0xa4, 0x4a, 0x5d, 0x0c, 0x32, 0x07, 0x8b, 0x0f, 0x3e, 0x3f, 0xc9, 0x41, 0xb1, 0xa5, 0x3d,
+	0xd2, 0x55, 0x56, 0xe0, 0xfa, 0x17, 0x3c, 0xd8, 0x9c, 0x27, 0xda, 0xa7, 0x79, 0x83, 0xb9, 0xed,
+	0x66, 0xab, 0x0a, 0x3b, 0xf7, 0x63, 0x48, 0xc3, 0xf6, 0x33, 0x04, 0xba, 0xe3, 0xd4, 0x77, 0x58,
+	0x5e, 0xf0, 0x62, 0xa4, 0x78, 0xb9, 0x98, 0x82, 0x95, 0x5d, 0x04, 0xb9, 0xa1, 0xe2, 0x3c, 0xe2,
+	0xd0, 0xdf, 0xe8, 0x16, 0x14, 0xc9, 0xbf, 0x84, 0x20, 0x88, 0x25, 0xa8, 0x40, 0x3e, 0xad, 0xd3,
+	0x2a, 0xdc, 0x50, 0x19, 0x9f, 0x90, 0x2c, 0x63, 0x64, 0xa8, 0xfc, 0xed, 0x06, 0xf8, 0xd4, 0x9e,
+	0xa1, 0x4a, 0x4a, 0x64, 0xc1, 0x17, 0xa0, 0xb0, 0xd7, 0xde, 0xad, 0xb7, 0x1a, 0x55, 0x81, 0xac,
+	0xef, 0xd6, 0xe5, 0x6e, 0xab, 0xbe, 0xbd, 0xfd, 0xb0, 0x47, 0xe7, 0x32, 0xe4, 0xb5, 0x84, 0xfe,
+	0xa2, 0x05, 0xdf, 0x9d, 0xfb, 0x4d, 0x99, 0x8e, 0x72, 0x24, 0x0f, 0x68, 0xbe, 0xb7, 0xdb, 0x92,
+	0xe9, 0xd3, 0xc9, 0x6f, 0x9d, 0x9a, 0x2b, 0xe7, 0x36, 0x41, 0xfe, 0xed, 0x51, 0x70, 0x66, 0xa2,
+	0x82, 0xb3, 0x93, 0x14, 0x9c, 0x0b, 0x55, 0x70, 0xde, 0xa3, 0x60, 0xff, 0x75, 0xa4, 0x10, 0xbc,
+	0x8e, 0x3c, 0x80, 0xc5, 0x00, 0xc5, 0xdc, 0x79, 0xde, 0x84, 0xa2, 0xca, 0xa6, 0xf8, 0x11, 0x70,
+	0x2d, 0x89, 0x7a, 0x65, 0x1b, 0x48, 0xba, 0xc1, 0xca, 0xb1, 0x89, 0x05, 0x21, 0xed, 0xc1, 0xbc,
+	0x0f, 0x60, 0x4a, 0x74, 0x7c, 0x29, 0xb0, 0xbc, 0x84, 0x2f, 0x4c, 0x3b, 0xe9, 0x72, 0x9d, 0x20,
+	0x77, 0x6e, 0x27, 0x90, 0xc6, 0xac, 0x1a, 0xe2, 0x92, 0xe8, 0x16, 0xaf, 0x39, 0x1b, 0xc9, 0x8a,
+	0xd7, 0x36, 0xf3, 0x0e, 0x54, 0x7c, 0x04, 0xfb, 0x2a, 0x03, 0x65, 0x59, 0x1f, 0x59, 0x98, 0xa6,
+	0x4f, 0x53, 0x3c, 0xb6, 0x26, 0x4a, 0x4c, 0x84, 0xd2, 0xd0, 0x50, 0x75, 0x43, 0xb5, 0xc6, 0x54,
+	0x66, 0x79, 0xd9, 0x19, 0x13, 0x72, 0x89, 0xd1, 0xf6, 0x86, 0x24, 0x33, 0x3a, 0xb5, 0x5f, 0x74,
+	0xc8, 0xd4, 0x2e, 0x9d, 0x21, 0xd6, 0x4c, 0x3f, 0x30, 0xf0, 0x11, 0x3e, 0xe5, 0x81, 0xac, 0x4c,
+	0x66, 0x64, 0x32, 0x41, 0x94, 0xca, 0xae, 0x06, 0xf6, 0x0d, 0x86, 0x8d, 0x7c, 0xbe, 0x54, 0xf2,
+	0xfb, 0x12, 0xc1, 0xa8, 0x6a, 0x3d, 0xee, 0x4f, 0x65, 0x8e, 0x51, 0xd5, 0xea, 0x74, 0x82, 0x2e,
+	0x2b, 0xa7, 0xf6, 0x32, 0xf0, 0x65, 0xe5, 0x94, 0x2f, 0xd7, 0xa0, 0xa8, 0x1c, 0x1c, 0xd0, 0xb5,
+	0x0a, 0xc7, 0xcb, 0x86, 0xd2, 0xaf, 0x33, 0x34, 0xa3, 0x73, 0x64, 0x6b, 0xdb, 0x5d, 0x50, 0xc4,
+	0x13, 0xfd, 0xdf, 0x2b, 0xa7, 0x6c, 0xb4, 0x9c, 0x72, 0x31, 0x72, 0xca, 0x4f, 0x96, 0x53, 0x61,
+	0xa2, 0x9c, 0x8a, 0x51, 0x72, 0x2a, 0x45, 0xcb, 0xa9, 0x1c, 0x21, 0x27, 0xf0, 0xcb, 0x89, 0xe5,
+	0x9f, 0x1e, 0x31, 0x9d, 0x23, 0xff, 0x74, 0xa1, 0x59, 0xfe, 0xb9, 0x62, 0xe7, 0x9f, 0x71, 0xd2,
+	0x77, 0x73, 0xcb, 0x33, 0x04, 0xd8, 0xb9, 0xa5, 0xb3, 0x90, 0x38, 0xb7, 0xf4, 0x42, 0x9c, 0x27,
+	0xb7, 0x74, 0x49, 0xe1, 0xb9, 0xe5, 0x97, 0x19, 0x98, 0x69, 0xb0, 0x9b, 0x16, 0x5d, 0x0b, 0x6b,
+	0x45, 0x10, 0x42, 0x5b, 0x11, 0xbe, 0xc6, 0x89, 0x87, 0xc7, 0x22, 0x8a, 0x3e, 0x8b, 0x20, 0x3b,
+	0x13, 0xbe, 0x6d, 0x5f, 0xcd, 0xc9, 0x05, 0x32, 0x64, 0x1e, 0xa1, 0x0f, 0xb1, 0xa1, 0x90, 0xdc,
+	0x90, 0x59, 0x98, 0x33, 0x96, 0x3e, 0x86, 0x1a, 0x91, 0xbd, 0x57, 0x4c, 0xd3, 0x0e, 0xf5, 0x1e,
+	0x8a, 0x73, 0x7e, 0x1b, 0xfe, 0x04, 0x2e, 0x87, 0x6c, 0xee, 0x54, 0xa6, 0x0a, 0x06, 0x9d, 0xe1,
+	0xca, 0x8f, 0xbe, 0x7c, 0x7b, 0x71, 0xc8, 0x1c, 0x30, 0x3e, 0x8a, 0x63, 0x78, 0x86, 0x10, 0xb0,
+	0xa7, 0x9d, 0x28, 0xd6, 0xc1, 0x31, 0xee, 0x73, 0x2c, 0x53, 0x96, 0x80, 0xf4, 0x43, 0x01, 0xae,
+	0x4e, 0xd8, 0xe7, 0x29, 0x32, 0xfb, 0x10, 0x16, 0xea, 0xa6, 0xa9, 0x1e, 0x69, 0x36, 0x38, 0x67,
+	0x32, 0xb1, 0x57, 0x78, 0x14, 0x99, 0xf1, 0x2b, 0xf2, 0x3d, 0x58, 0x0c, 0xa0, 0x76, 0xae, 0x12,
+	0x79, 0x4a, 0x1e, 0x0f, 0x47, 0x29, 0xd8, 0x62, 0x70, 0xd2, 0x0f, 0xb2, 0x50, 0xd9, 0xc6, 0xfd,
+	0x23, 0x6c, 0x34, 0x35, 0xcb, 0x18, 0x4f, 0xf3, 0xa4, 0xf5, 0xe6, 0x54, 0x59, 0x7f, 0x72, 0x59,
+	0xe7, 0x8f, 0x1d, 0x2c, 0x37, 0x79, 0x29, 0x92, 0x6a, 0x0f, 0x75, 0x81, 0x7e, 0xa8, 0xf0, 0x42,
+	0xa2, 0x47, 0x8a, 0x05, 0xbf, 0x03, 0x47, 0x9c, 0x22, 0x6e, 0x84, 0x28, 0x85, 0x46, 0x88, 0xb2,
+	0x1b, 0x21, 0xa4, 0x9d, 0x89, 0x8d, 0x48, 0x15, 0x28, 0x36, 0x9a, 0xbb, 0x3b, 0x9d, 0x56, 0x97,
+	0xf5, 0x21, 0x75, 0xe5, 0x7a, 0xbb, 0x73, 0xb7, 0x29, 0x57, 0x33, 0x64, 0xf4, 0xa0, 0xd5, 0xdd,
+	0x6a, 0xc8, 0xf5, 0x07, 0x2c, 0x57, 0x97, 0x9b, 0xf7, 0x9b, 0x72, 0xa7, 0xbe, 0x5d, 0xcd, 0x49,
+	0xbf, 0x13, 0x60, 0xae, 0xce, 0x68, 0xb4, 0xbb, 0x12, 0x26, 0xd6, 0x23, 0x26, 0xda, 0xc8, 0x79,
+	0xf2, 0xf2, 0xdb, 0x00, 0xa3, 0x61, 0xdf, 0x56, 0x6e, 0x3e, 0x5e, 0xb9, 0xfc, 0xeb, 0xba, 0x25,
+	0x7d, 0x25, 0xc0, 0xa5, 0x96, 0xc6, 0xde, 0xdb, 0x53, 0x54, 0xf5, 0xcf, 0x73, 0xab, 0x78, 0x0e,
+	0x66, 0x0e, 0x0d, 0xfd, 0xa4, 0xe7, 0x0f, 0x72, 0x15, 0x32, 0xc7, 0xa5, 0x46, 0x4e, 0x79, 0x4b,
+	0x77, 0x3e, 0xe0, 0x69, 0x85, 0xa5, 0xdb, 0xcb, 0x2e, 0xff, 0x85, 0x50, 0xed, 0x16, 0x3d, 0xda,
+	0xfd, 0x36, 0xd4, 0xce, 0xf2, 0xc5, 0xbd, 0x6d, 0x13, 0x8a, 0x58, 0xb3, 0x0c, 0xd5, 0x09, 0x23,
+	0x2b, 0x49, 0x2d, 0x57, 0xb6, 0x01, 0xa5, 0xbf, 0x09, 0xb0, 0xf4, 0x40, 0xb5, 0x8e, 0xfb, 0x86,
+	0xf2, 0x98, 0xd3, 0xf7, 0x24, 0x72, 0xf3, 0xd8, 0x43, 0x76, 0xb2, 0x3d, 0xfc, 0x7b, 0x9a, 0x21,
+	0xc9, 0x5b, 0xcd, 0x19, 0x56, 0xa7, 0xf7, 0x56, 0xb3, 0x03, 0x22, 0xad, 0xb0, 0xf8, 0x3c, 0x27,
+	0x36, 0x1b, 0x8a, 0x88, 0xb2, 0x87, 0x70, 0x25, 0x14, 0x21, 0x27, 0xf9, 0x9d, 0x33, 0x2d, 0x3b,
+	0xd1, 0x97, 0x2a, 0x3f, 0x1e, 0x4f, 0xe7, 0xce, 0x17, 0x02, 0x4b, 0x0a, 0x5c, 0xfb, 0x50, 0x9f,
+	0x5e, 0x52, 0xe0, 0xb3, 0x8b, 0xbc, 0xbf, 0xa2, 0xf9, 0xa9, 0x00, 0x97, 0x43, 0x08, 0x9b, 0x9e,
+	0xf5, 0xc7, 0x1f, 0xa2, 0xff, 0xc8, 0x41, 0xa1, 0x69, 0x1e, 0x18, 0xfa, 0xe3, 0xa7, 0x5d, 0xb8,
+	0xba, 0x02, 0x65, 0x46, 0xb9, 0xeb, 0x30, 0x25, 0x36, 0xd1, 0xea, 0x47, 0x08, 0x2d, 0x4d, 0x70,
+	0x61, 0xd5, 0xce, 0x31, 0xc6, 0xa4, 0x34, 0x95, 0x65, 0xd5, 0x4e, 0x32, 0xf2, 0xbb, 0x51, 0x39,
+	0xf8, 0xa4, 0x7b, 0x0d, 0xe6, 0x14, 0x63, 0x5f, 0xb5, 0xb0, 0xd1, 0xfb, 0x08, 0x8f, 0x09, 0x09,
+	0x40, 0xe5, 0x36, 0xc3, 0x67, 0xef, 0xe1, 0x71, 0xab, 0x8f, 0x5e, 0x81, 0x52, 0x1f, 0x2b, 0xfd,
+	0x81, 0xaa, 0xe1, 0x5a, 0x25, 0x56, 0x22, 0xce, 0xb7, 0x9e, 0x76, 0xa8, 0x99, 0x04, 0xad, 0x07,
+	0x4c, 0x37, 0xc1, 0x6a, 0xd9, 0x55, 0x00, 0x13, 0x5b, 0x16, 0x79, 0x61, 0xd8, 0x1f, 0xf3, 0x67,
+	0xad, 0x32, 0x9f, 0xd9, 0x1c, 0x13, 0x75, 0xd9, 0xcb, 0x89, 0xfa, 0x8a, 0x6d, 0xd0, 0xba, 0x25,
+	0xed, 0xc7, 0x55, 0xc7, 0xb6, 0x77, 0xee, 0xdc, 0x6b, 0x92, 0xea, 0xd8, 0x2c, 0x94, 0x59, 0xb7,
+	0x30, 0xe9, 0x8b, 0xcc, 0xb0, 0xe1, 0xdd, 0x3d, 0xd6, 0x70, 0x9c, 0xf5, 0xf5, 0x12, 0xf3, 0xce,
+	0x62, 0xb2, 0x48, 0xcb, 0x63, 0x3f, 0xcd, 0xd8, 0xcd, 0x91, 0x8c, 0xbb, 0xaf, 0x41, 0x75, 0xcc,
+	0x35, 0x94, 0xc2, 0x64, 0x43, 0x29, 0xc6, 0x1b, 0x4a, 0x29, 0xc4, 0x50, 0x9e, 0x85, 0x8a, 0xad,
+	0x7c, 0x52, 0x7a, 0x2b, 0xd3, 0xd2, 0x1b, 0xd8, 0x53, 0x2d, 0x4d, 0xfa, 0xdc, 0x29, 0x17, 0xda,
+	0xf2, 0x70, 0xfb, 0x98, 0x30, 0x9d, 0x49, 0xd4, 0xc7, 0xc4, 0x81, 0x39, 0x08, 0xba, 0x0d, 0x39,
+	0xd2, 0xa7, 0x91, 0xee, 0x0d, 0x9b, 0x82, 0x48, 0xab, 0xac, 0xe1, 0x32, 0xa9, 0x76, 0xa4, 0x77,
+	0x01, 0x79, 0xbf, 0x9f, 0x02, 0xf5, 0xd2, 0x1a, 0xe9, 0x33, 0xa1, 0xcf, 0xe9, 0x89, 0xa9, 0xf8,
+	0x85, 0x00, 0x8b, 0x01, 0x98, 0x69, 0xc8, 0xd1, 0x7b, 0x72, 0x66, 0xce, 0xdd, 0xe6, 0xcd, 0x9a,
+	0x66, 0x12, 0xf3, 0xf2, 0x85, 0x00, 0x0b, 0x7e, 0x90, 0xaf, 0x07, 0x2b, 0xeb, 0xbf, 0xb9, 0x06,
+	0x17, 0x3b, 0xca, 0x21, 0xef, 0xb8, 0xeb, 0x60, 0xe3, 0x11, 0x79, 0xf3, 0x18, 0xc3, 0x9c, 0xbf,
+	0x49, 0x1e, 0xad, 0x47, 0x22, 0x0e, 0xfd, 0x0b, 0x01, 0xf1, 0x56, 0x2a, 0x18, 0x2e, 0x10, 0x13,
+	0x66, 0xbc, 0x0d, 0xf1, 0xe8, 0x66, 0x24, 0x92, 0x90, 0x6e, 0x7b, 0x71, 0x2d, 0x05, 0x04, 0xdf,
+	0xf4, 0x11, 0xcc, 0xfa, 0x9a, 0xbf, 0x50, 0x34, 0x8e, 0xb0, 0x16, 0x37, 0x71, 0x3d, 0x0d, 0x88,
+	0xcb, 0xac, 0xb7, 0xfb, 0x2a, 0x86, 0xd9, 0x90, 0x06, 0x31, 0x71, 0x2d, 0x05, 0x84, 0xbb, 0xa9,
+	0xb7, 0x53, 0x29, 0x66, 0xd3, 0x90, 0x0e, 0x2e, 0x71, 0x2d, 0x05, 0x04, 0xdf, 0xf4, 0x7b, 0x70,
+	0x21, 0xd0, 0x76, 0x84, 0x62, 0xcc, 0x23, 0xb4, 0xe3, 0x49, 0xdc, 0x48, 0x07, 0xe4, 0xee, 0x1e,
+	0x68, 0x30, 0x8a, 0xd9, 0x3d, 0xbc, 0x89, 0x49, 0xdc, 0x48, 0x07, 0xc4, 0x77, 0x1f, 0x42, 0xc5,
+	0xd3, 0x41, 0x81, 0x6e, 0xc4, 0x1a, 0x8a, 0xbf, 0x8f, 0x44, 0xbc, 0x99, 0x1c, 0xc0, 0x55, 0xb1,
+	0xf7, 0x6f, 0x04, 0x62, 0x54, 0x1c, 0xf2, 0x87, 0x0d, 0xe2, 0x5a, 0x0a, 0x08, 0xbe, 0xe9, 0x09,
+	0x80, 0xdb, 0x0e, 0x8f, 0x56, 0x63, 0xbd, 0xd0, 0xbf, 0xe1, 0x8d, 0xc4, 0xdf, 0xf3, 0xed, 0x7e,
+	0x26, 0xc0, 0x52, 0x78, 0xfb, 0x3d, 0x7a, 0x35, 0x21, 0xae, 0x90, 0xae, 0x7f, 0xf1, 0xb5, 0x73,
+	0xc1, 0xfa, 0x35, 0xfd, 0x80, 0xf7, 0xa3, 0xc7, 0x6b, 0xda, 0xdf, 0xdb, 0x2f, 0xde, 0x4c, 0x0e,
+	0xe0, 0x09, 0x97, 0x9e, 0x46, 0xef, 0xb8, 0x70, 0x79, 0xb6, 0xbb, 0x5c, 0x5c, 0x4b, 0x01, 0xc1,
+	0x37, 0x1d, 0xc3, 0x9c, 0xbf, 0xbf, 0x3c, 0xe6, 0x78, 0x08, 0x6d, 0x6b, 0x17, 0x6f, 0xa5, 0x82,
+	0x71, 0x23, 0xb5, 0xaf, 0x47, 0x3c, 0x26, 0x52, 0x87, 0xf5, 0xab, 0x8b, 0xeb, 0x69, 0x40, 0x5c,
+	0xcd, 0x7a, 0x9a, 0xb7, 0x63, 0x34, 0x7b, 0xb6, 0x61, 0x5c, 0xbc, 0x99, 0x1c, 0x80, 0xef, 0x78,
+	0x04, 0x25, 0xbb, 0x49, 0x1b, 0x5d, 0x8f, 0x87, 0x76, 0x3b, 0xbf, 0xc5, 0x97, 0x12, 0x7e, 0xed,
+	0x9a, 0x90, 0xb7, 0x53, 0x28, 0xc6, 0x84, 0x42, 0xba, 0x99, 0xc4, 0xb5, 0x14, 0x10, 0x6e, 0x44,
+	0x0e, 0x34, 0xff, 0xc4, 0x44, 0xe4, 0xf0, 0xc6, 0x23, 0x71, 0x23, 0x1d, 0x90, 0x6b, 0xc0, 0xfe,
+	0x6e, 0x21, 0x94, 0xe0, 0xf4, 0x0e, 0x36, 0x23, 0x89, 0xb7, 0x52, 0xc1, 0xb8, 0x8c, 0x07, 0xfa,
+	0x5a, 0x50, 0x3c, 0x9e, 0xb3, 0xbd, 0x37, 0xe2, 0x46, 0x3a, 0x20, 0xd7, 0x7d, 0x7c, 0x6d, 0x01,
+	0x28, 0x49, 0x9c, 0xf7, 0xbf, 0xf5, 0x8b, 0xeb, 0x69, 0x40, 0x5c, 0xf7, 0xf1, 0x34, 0x01, 0xa0,
+	0xf8, 0x60, 0x1f, 0xd8, 0xf3, 0x66, 0x72, 0x00, 0xd7, 0xaa, 0xbd, 0x6f, 0xef, 0x28, 0x3e, 0xb4,
+	0x06, 0x3a, 0x09, 0xc4, 0xb5, 0x14, 0x10, 0x3e, 0x57, 0x72, 0xdf, 0xdd, 0x63, 0x5d, 0x29, 0xf8,
+	0x90, 0x29, 0xae, 0xa5, 0x80, 0x08, 0xba, 0x92, 0xbb, 0x6f, 0x12, 0x57, 0x3a, 0xb3, 0xf5, 0x46,
+	0x3a, 0x20, 0xbf, 0x2b, 0x39, 0x0b, 0x49, 0x5c, 0xe9, 0xcc, 0xdb, 0xab, 0x78, 0x2b, 0x15, 0x0c,
+	0xdf, 0xfa, 0x33, 0x01, 0x2e, 0x9e, 0x79, 0x9f, 0x43, 0x2f, 0xc7, 0xa2, 0x0a, 0x7b, 0x4c, 0x14,
+	0x5f, 0x49, 0x0b, 0xc6, 0x89, 0xf8, 0x5c, 0x80, 0xc5, 0xd0, 0xb7, 0x33, 0x74, 0x3b, 0x16, 0xe3,
+	0xa4, 0x77, 0x3d, 0xf1, 0xd5, 0xf3, 0x80, 0x7a, 0x4e, 0x48, 0xef, 0x5b, 0x57, 0xdc, 0x09, 0x19,
+	0xf2, 0xe4, 0x26, 0xae, 0xa7, 0x01, 0xe1, 0xfb, 0x7e, 0x02, 0xd5, 0x60, 0xe1, 0x1f, 0x6d, 0xc4,
+	0xb4, 0xb5, 0x84, 0xbe, 0x7f, 0x88, 0x2f, 0xa7, 0x84, 0x72, 0xfd, 0x20, 0x50, 0x2d, 0x8f, 0xf1,
+	0x83, 0xf0, 0x67, 0x04, 0x71, 0x23, 0x1d, 0x10, 0xdf, 0xfd, 0xc7, 0xbc, 0x1f, 0x29, 0x50, 0xfd,
+	0x46, 0xdf, 0x88, 0x8f, 0xd3, 0xa1, 0x05, 0x78, 0xf1, 0x9b, 0xe9, 0x01, 0x03, 0x7e, 0xe1, 0x2b,
+	0x43, 0x27, 0xf0, 0x8b, 0xb0, 0x7a, 0xba, 0xf8, 0x4a, 0x5a, 0xb0, 0xe0, 0x15, 0x84, 0x57, 0xa3,
+	0x93, 0x5c, 0x41, 0x7c, 0xe5, 0x14, 0x71, 0x2d, 0x05, 0x84, 0xff, 0x0a, 0xc2, 0xb7, 0x8c, 0xbf,
+	0x82, 0xf8, 0x37, 0xbc, 0x91, 0xf8, 0x7b, 0xd7, 0xd5, 0x7c, 0x05, 0x2a, 0xb4, 0x96, 0xe4, 0x7e,
+	0xe8, 0xdf, 0x74, 0x3d, 0x0d, 0x48, 0xb0, 0x6c, 0x90, 0x48, 0xb6, 0x21, 0xa5, 0x2a, 0x71, 0x2d,
+	0x05, 0x04, 0xdb, 0x74, 0xb3, 0xfa, 0xfe, 0x1c, 0xf9, 0xdf, 0x51, 0xdc, 0xcf, 0xf6, 0x0b, 0xb4,
+	0xf4, 0x7c, 0xeb, 0x9f, 0x03, 0x00, 0x1e, 0x65, 0x69, 0xcc, 0x36, 0x45, 0x00, 0x00,
 }
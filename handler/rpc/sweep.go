@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/twitchtv/twirp"
+)
+
+func (s *Server) SweepWallet(ctx context.Context, req *safewallet.SweepWalletRequest) (*safewallet.SweepWalletResponse, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid user id")
+	}
+
+	if req.AssetId != "" {
+		if _, err := uuid.Parse(req.AssetId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid asset id")
+		}
+	}
+
+	if _, err := s.findWallet(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	transfers, err := s.sweepz.SweepWallet(ctx, req.UserId, req.AssetId)
+	if err != nil {
+		if errors.Is(err, core.ErrSweepNotAllowed) {
+			return nil, twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		s.logger.Error("sweepz.SweepWallet", "err", err, "user", req.UserId)
+		return nil, err
+	}
+
+	resp := &safewallet.SweepWalletResponse{}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, viewTransfer(transfer))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) SweepAll(ctx context.Context, req *safewallet.SweepAllRequest) (*safewallet.SweepAllResponse, error) {
+	if req.AssetId != "" {
+		if _, err := uuid.Parse(req.AssetId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid asset id")
+		}
+	}
+
+	// the wallets failing to sweep are logged, they don't hold back the others
+	transfers, err := s.sweepz.SweepAll(ctx, req.AssetId)
+	if err != nil {
+		s.logger.Error("sweepz.SweepAll", "err", err, "swept", len(transfers))
+	}
+
+	resp := &safewallet.SweepAllResponse{}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, viewTransfer(transfer))
+	}
+
+	return resp, nil
+}
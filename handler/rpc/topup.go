@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/shopspring/decimal"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SetTopupRule(ctx context.Context, req *safewallet.SetTopupRuleRequest) (*safewallet.SetTopupRuleResponse, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid user id")
+	}
+
+	if _, err := uuid.Parse(req.AssetId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid asset id")
+	}
+
+	if req.UserId == s.defaultUserID {
+		return nil, twirp.InvalidArgument.Error("master wallet can not be topped up")
+	}
+
+	rule := &core.TopupRule{
+		UserID:  req.UserId,
+		AssetID: req.AssetId,
+		Floor:   generic.Try(decimal.NewFromString(req.Floor)),
+		Target:  generic.Try(decimal.NewFromString(req.Target)),
+	}
+
+	if rule.Floor.IsNegative() || rule.Floor.Truncate(8).LessThan(rule.Floor) {
+		return nil, twirp.InvalidArgument.Error("invalid floor")
+	}
+
+	if !rule.Target.GreaterThan(rule.Floor) || rule.Target.Truncate(8).LessThan(rule.Target) {
+		return nil, twirp.InvalidArgument.Error("invalid target, must be greater than floor")
+	}
+
+	for _, sweep := range s.sweepz.Rules() {
+		if rule.ConflictsWith(sweep) {
+			return nil, twirp.InvalidArgument.Errorf("target must be below the sweep min amount %s", sweep.MinAmount)
+		}
+	}
+
+	if _, err := s.findWallet(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.topups.Save(ctx, rule); err != nil {
+		s.logger.Error("topups.Save", "err", err)
+		return nil, err
+	}
+
+	rules, err := s.topups.List(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("topups.List", "err", err)
+		return nil, err
+	}
+
+	for _, r := range rules {
+		if r.AssetID == rule.AssetID {
+			rule = r
+			break
+		}
+	}
+
+	return &safewallet.SetTopupRuleResponse{Rule: viewTopupRule(rule)}, nil
+}
+
+func (s *Server) DeleteTopupRule(ctx context.Context, req *safewallet.DeleteTopupRuleRequest) (*safewallet.DeleteTopupRuleResponse, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid user id")
+	}
+
+	if _, err := uuid.Parse(req.AssetId); err != nil {
+		return nil, twirp.InvalidArgument.Error("invalid asset id")
+	}
+
+	if err := s.topups.Delete(ctx, req.UserId, req.AssetId); err != nil {
+		s.logger.Error("topups.Delete", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.DeleteTopupRuleResponse{}, nil
+}
+
+func (s *Server) ListTopupRules(ctx context.Context, req *safewallet.ListTopupRulesRequest) (*safewallet.ListTopupRulesResponse, error) {
+	if req.UserId != "" {
+		if _, err := uuid.Parse(req.UserId); err != nil {
+			return nil, twirp.InvalidArgument.Error("invalid user id")
+		}
+	}
+
+	rules, err := s.topups.List(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("topups.List", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListTopupRulesResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, viewTopupRule(rule))
+	}
+
+	return resp, nil
+}
+
+func viewTopupRule(rule *core.TopupRule) *safewallet.TopupRule {
+	view := &safewallet.TopupRule{
+		UserId:  rule.UserID,
+		AssetId: rule.AssetID,
+		Floor:   rule.Floor.String(),
+		Target:  rule.Target.String(),
+		Refills: rule.Refills,
+	}
+
+	if !rule.RefilledAt.IsZero() {
+		view.RefilledAt = timestamppb.New(rule.RefilledAt)
+	}
+
+	return view
+}
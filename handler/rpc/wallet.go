@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) FindWalletByExternalID(ctx context.Context, req *safewallet.FindWalletByExternalIDRequest) (*safewallet.FindWalletByExternalIDResponse, error) {
+	if req.ExternalId == "" {
+		return nil, twirp.RequiredArgumentError("external_id")
+	}
+
+	wallet, err := s.wallets.FindExternal(ctx, req.ExternalId)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("wallet not found")
+		}
+
+		s.logger.Error("wallets.FindExternal", "err", err)
+		return nil, err
+	}
+
+	return &safewallet.FindWalletByExternalIDResponse{Wallet: viewWallet(wallet)}, nil
+}
+
+func (s *Server) ListWallets(ctx context.Context, req *safewallet.ListWalletsRequest) (*safewallet.ListWalletsResponse, error) {
+	if req.Metadata != "" && !validMetadata(req.Metadata) {
+		return nil, twirp.InvalidArgument.Error("invalid metadata")
+	}
+
+	const maxLimit = 500
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	wallets, err := s.wallets.List(ctx, core.WalletQuery{
+		Offset:      req.Offset,
+		Limit:       limit,
+		Status:      core.WalletStatus(req.Status),
+		Label:       req.Label,
+		ExternalID:  req.ExternalId,
+		Metadata:    json.RawMessage(req.Metadata),
+		ExcludePool: true,
+	})
+	if err != nil {
+		s.logger.Error("wallets.List", "err", err)
+		return nil, err
+	}
+
+	resp := &safewallet.ListWalletsResponse{NextOffset: req.Offset}
+	for _, wallet := range wallets {
+		resp.Wallets = append(resp.Wallets, viewWallet(wallet))
+		resp.NextOffset = wallet.ID
+	}
+
+	return resp, nil
+}
+
+// validMetadata reports whether metadata is a json object of acceptable size
+func validMetadata(metadata string) bool {
+	const maxSize = 4096
+	if len(metadata) > maxSize {
+		return false
+	}
+
+	var m map[string]any
+	return json.Unmarshal([]byte(metadata), &m) == nil
+}
+
+func viewWallet(wallet *core.Wallet) *safewallet.Wallet {
+	return &safewallet.Wallet{
+		UserId:     wallet.UserID,
+		CreatedAt:  timestamppb.New(wallet.CreatedAt),
+		Status:     safewallet.Wallet_Status(wallet.Status),
+		Label:      wallet.Label,
+		ExternalId: wallet.ExternalID,
+		Metadata:   string(wallet.Metadata),
+	}
+}
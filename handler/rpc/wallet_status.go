@@ -0,0 +1,197 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/handler/auth"
+	"github.com/pandodao/safe-wallet/handler/rpc/safewallet"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/twitchtv/twirp"
+)
+
+func (s *Server) FreezeWallet(ctx context.Context, req *safewallet.FreezeWalletRequest) (*safewallet.FreezeWalletResponse, error) {
+	wallet, err := s.updateWalletStatus(ctx, req.UserId, req.Reason, core.WalletStatusFrozen, core.WalletStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.FreezeWalletResponse{Wallet: viewWallet(wallet)}, nil
+}
+
+func (s *Server) UnfreezeWallet(ctx context.Context, req *safewallet.UnfreezeWalletRequest) (*safewallet.UnfreezeWalletResponse, error) {
+	wallet, err := s.updateWalletStatus(ctx, req.UserId, req.Reason, core.WalletStatusActive, core.WalletStatusFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	return &safewallet.UnfreezeWalletResponse{Wallet: viewWallet(wallet)}, nil
+}
+
+// ArchiveWallet archives a wallet with zero balance. If the wallet still holds
+// funds and sweep is set, the balances are transferred to the archive destination
+// instead, and the wallet can be archived by calling again once they are handled.
+// The sweeps are checked like other transfers, funds allocated to virtual
+// accounts are not swept. Deposits made after the wallet is archived are still
+// accepted, the sweeper moves them to the archive destination.
+func (s *Server) ArchiveWallet(ctx context.Context, req *safewallet.ArchiveWalletRequest) (*safewallet.ArchiveWalletResponse, error) {
+	if err := validateReason(req.Reason); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.findWallet(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if wallet.Status == core.WalletStatusArchived {
+		return &safewallet.ArchiveWalletResponse{Wallet: viewWallet(wallet)}, nil
+	}
+
+	balances, err := s.outputs.SumBalances(ctx, wallet.UserID, "")
+	if err != nil {
+		s.logger.Error("outputs.SumBalances", "err", err)
+		return nil, err
+	}
+
+	// outputs of transfers in flight are assigned already, they are not in the
+	// balances but the wallet must stay open until they are sent
+	inflight, err := s.transfers.CountInflight(ctx, wallet.UserID)
+	if err != nil {
+		s.logger.Error("transfers.CountInflight", "err", err)
+		return nil, err
+	}
+
+	if len(balances) == 0 && inflight > 0 {
+		return nil, twirp.FailedPrecondition.Errorf("wallet has %d transfers in flight", inflight)
+	}
+
+	if len(balances) == 0 {
+		wallet, err := s.updateWalletStatus(ctx, req.UserId, req.Reason, core.WalletStatusArchived, core.WalletStatusActive, core.WalletStatusFrozen)
+		if err != nil {
+			return nil, err
+		}
+
+		return &safewallet.ArchiveWalletResponse{Wallet: viewWallet(wallet)}, nil
+	}
+
+	if !req.Sweep {
+		return nil, twirp.FailedPrecondition.Error("wallet balance is not zero")
+	}
+
+	destination := s.sweepz.ArchiveDestination()
+	if destination == nil {
+		return nil, twirp.FailedPrecondition.Error("archive destination not configured")
+	}
+
+	if wallet.Status != core.WalletStatusActive {
+		return nil, twirp.FailedPrecondition.Error("only active wallets can be swept")
+	}
+
+	resp := &safewallet.ArchiveWalletResponse{Wallet: viewWallet(wallet)}
+	for _, balance := range balances {
+		offset, err := s.transfers.GetAssignOffset(ctx, balance.UserID, balance.AssetID)
+		if err != nil {
+			s.logger.Error("transfers.GetAssignOffset", "err", err)
+			return nil, err
+		}
+
+		// the assign offset moves after every sweep, so each round gets a new trace
+		memo := fmt.Sprintf("archive %s %s from %d", balance.UserID, balance.AssetID, offset)
+		transfer := &core.Transfer{
+			TraceID:  uuid.NewSHA1(uuid.NameSpaceOID, []byte(memo)).String(),
+			UserID:   balance.UserID,
+			Status:   core.TransferStatusPending,
+			AssetID:  balance.AssetID,
+			Amount:   balance.Amount,
+			Memo:     core.ArchiveSweepMemo,
+			Opponent: destination,
+		}
+
+		if err := s.validateTransfer(ctx, transfer); err != nil {
+			return nil, err
+		}
+
+		if err := s.createTransfer(ctx, transfer); err != nil {
+			return nil, err
+		}
+
+		resp.Sweeps = append(resp.Sweeps, viewTransfer(transfer))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) findWallet(ctx context.Context, userID string) (*core.Wallet, error) {
+	wallet, err := s.wallets.FindLatest(ctx, userID)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil, twirp.NotFoundError("wallet not found")
+		}
+
+		s.logger.Error("wallets.FindLatest", "err", err)
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+// updateWalletStatus moves the wallet between the statuses, the authenticated
+// key is recorded as the operator of the change
+func (s *Server) updateWalletStatus(ctx context.Context, userID, reason string, to core.WalletStatus, from ...core.WalletStatus) (*core.Wallet, error) {
+	if err := validateReason(reason); err != nil {
+		return nil, err
+	}
+
+	key, _ := auth.KeyFrom(ctx)
+	operator := key.Principal()
+
+	wallet, err := s.findWallet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wallet.Status == to {
+		return wallet, nil
+	}
+
+	if !slices.Contains(from, wallet.Status) {
+		return nil, twirp.FailedPrecondition.Errorf("wallet is %s", wallet.Status)
+	}
+
+	logger := s.logger.With("user", wallet.UserID, "from", wallet.Status, "to", to, "operator", operator)
+
+	if err := s.wallets.UpdateStatus(ctx, wallet, &core.WalletEvent{
+		To:     to,
+		Actor:  operator,
+		Reason: reason,
+	}); err != nil {
+		logger.Error("wallets.UpdateStatus", "err", err)
+		return nil, err
+	}
+
+	logger.Info("wallet status updated", "reason", reason)
+
+	wallet, err = s.wallets.FindLatest(ctx, userID)
+	if err != nil {
+		logger.Error("wallets.FindLatest", "err", err)
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+func validateReason(reason string) error {
+	if reason == "" {
+		return twirp.RequiredArgumentError("reason")
+	}
+
+	if len(reason) > 255 {
+		return twirp.InvalidArgument.Error("reason too long")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package escrow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+)
+
+func New(
+	outputs core.OutputStore,
+	transfers core.TransferStore,
+	escrows core.EscrowStore,
+) core.EscrowService {
+	return &service{
+		outputs:   outputs,
+		transfers: transfers,
+		escrows:   escrows,
+	}
+}
+
+type service struct {
+	outputs   core.OutputStore
+	transfers core.TransferStore
+	escrows   core.EscrowStore
+}
+
+func (s *service) Settle(ctx context.Context, escrow *core.Escrow) (*core.Transfer, error) {
+	var to core.EscrowStatus
+	switch escrow.Status {
+	case core.EscrowStatusReleasing:
+		to = core.EscrowStatusReleased
+	case core.EscrowStatusRefunding:
+		to = core.EscrowStatusRefunded
+	default:
+		return nil, fmt.Errorf("escrow is %s", escrow.Status)
+	}
+
+	transfer := escrow.SettleTransfer()
+
+	if existing, err := s.transfers.FindTrace(ctx, transfer.TraceID); err == nil {
+		transfer = existing
+	} else if !store.IsErrNotFound(err) {
+		return nil, err
+	} else if err := s.assign(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	if err := s.escrows.UpdateStatus(ctx, escrow, to, ""); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// assign spends the outputs of the escrow wallet, they only come from the fund transfer
+func (s *service) assign(ctx context.Context, transfer *core.Transfer) error {
+	offset, err := s.transfers.GetAssignOffset(ctx, transfer.UserID, transfer.AssetID)
+	if err != nil {
+		return err
+	}
+
+	const limit = 256
+	outputs, err := s.outputs.ListTarget(ctx, transfer.UserID, transfer.AssetID, offset, transfer.Amount, limit)
+	if err != nil {
+		return err
+	}
+
+	if len(outputs) == 0 {
+		return core.ErrEscrowUnfunded
+	}
+
+	var sum decimal.Decimal
+	transfer.AssignRange[0] = outputs[0].Sequence
+	for _, output := range outputs {
+		sum = sum.Add(output.Amount)
+		transfer.AssignRange[1] = output.Sequence
+	}
+
+	if sum.LessThan(transfer.Amount) {
+		return core.ErrEscrowUnfunded
+	}
+
+	return s.transfers.Assign(ctx, transfer, offset)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/pandodao/safe-wallet/core"
 )
 
@@ -18,7 +19,7 @@ type service struct {
 }
 
 func (s *service) Pull(ctx context.Context, offset uint64, limit int) ([]*core.Output, uint64, error) {
-	utxos, err := s.client.SafeListUtxos(ctx, mixin.SafeListUtxoOption{
+	utxos, err := s.listUtxos(ctx, mixin.SafeListUtxoOption{
 		Members:           []string{s.client.ClientID},
 		Threshold:         1,
 		Offset:            offset,
@@ -30,22 +31,18 @@ func (s *service) Pull(ctx context.Context, offset uint64, limit int) ([]*core.O
 		return nil, 0, err
 	}
 
+	// signed & spent outputs are returned too, the store moves their states forward
 	var outputs []*core.Output
 	for _, utxo := range utxos {
 		offset = utxo.Sequence + 1
-
-		if utxo.State != mixin.SafeUtxoStateUnspent {
-			continue
-		}
-
 		outputs = append(outputs, utxoToOutput(utxo))
 	}
 
 	return outputs, offset, nil
 }
 
-func utxoToOutput(utxo *mixin.SafeUtxo) *core.Output {
-	return &core.Output{
+func utxoToOutput(utxo *utxo) *core.Output {
+	output := &core.Output{
 		Sequence:  utxo.Sequence,
 		CreatedAt: utxo.CreatedAt,
 		Hash:      utxo.TransactionHash,
@@ -53,11 +50,40 @@ func utxoToOutput(utxo *mixin.SafeUtxo) *core.Output {
 		UserID:    utxo.Receivers[0],
 		AssetID:   utxo.AssetID,
 		Amount:    utxo.Amount,
+		State:     core.OutputStateUnspent,
+
+		Senders:          utxo.Senders,
+		SendersThreshold: utxo.SendersThreshold,
+		Extra:            utxo.Extra,
+		Memo:             decodeMemo(utxo.Extra),
 	}
+
+	if _, err := mixinnet.HashFromString(utxo.InscriptionHash); err == nil {
+		output.InscriptionHash = utxo.InscriptionHash
+	}
+
+	switch utxo.State {
+	case mixin.SafeUtxoStateSigned:
+		output.State = core.OutputStateSigned
+	case mixin.SafeUtxoStateSpent:
+		output.State = core.OutputStateSpent
+		output.SpentAt = utxo.UpdatedAt
+		if utxo.SpentAt != nil {
+			output.SpentAt = *utxo.SpentAt
+		}
+	}
+
+	if output.State != core.OutputStateUnspent {
+		if _, err := mixinnet.HashFromString(utxo.SignedBy); err == nil {
+			output.SpentBy = utxo.SignedBy
+		}
+	}
+
+	return output
 }
 
 func (s *service) ListRange(ctx context.Context, assetID string, from, to uint64) ([]*core.Output, error) {
-	utxos, err := s.client.SafeListUtxos(ctx, mixin.SafeListUtxoOption{
+	utxos, err := s.listUtxos(ctx, mixin.SafeListUtxoOption{
 		Members:   []string{s.client.ClientID},
 		Threshold: 1,
 		Offset:    from,
@@ -84,3 +110,35 @@ func (s *service) ListRange(ctx context.Context, assetID string, from, to uint64
 
 	return outputs, nil
 }
+
+func (s *service) Head(ctx context.Context) (uint64, error) {
+	utxos, err := s.listUtxos(ctx, mixin.SafeListUtxoOption{
+		Members:           []string{s.client.ClientID},
+		Threshold:         1,
+		Limit:             1,
+		Order:             "DESC",
+		IncludeSubWallets: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(utxos) == 0 {
+		return 0, nil
+	}
+
+	return utxos[0].Sequence, nil
+}
+
+func (s *service) Find(ctx context.Context, hash mixinnet.Hash, index uint8) (*core.Output, error) {
+	utxo, err := s.readUtxo(ctx, hash, index)
+	if err != nil {
+		if mixin.IsErrorCodes(err, mixin.EndpointNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return utxoToOutput(utxo), nil
+}
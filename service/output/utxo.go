@@ -0,0 +1,75 @@
+package output
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+)
+
+// utxo adds the fields mixin.SafeUtxo doesn't decode
+type utxo struct {
+	mixin.SafeUtxo
+	InscriptionHash string `json:"inscription_hash,omitempty"`
+}
+
+// listUtxos is client.SafeListUtxos decoding into utxo
+func (s *service) listUtxos(ctx context.Context, opt mixin.SafeListUtxoOption) ([]*utxo, error) {
+	params := map[string]string{
+		"members":   mixinnet.HashMembers(opt.Members),
+		"threshold": fmt.Sprint(opt.Threshold),
+		"order":     opt.Order,
+	}
+
+	if opt.Offset > 0 {
+		params["offset"] = fmt.Sprint(opt.Offset)
+	}
+
+	if opt.Limit > 0 {
+		params["limit"] = strconv.Itoa(opt.Limit)
+	}
+
+	if opt.IncludeSubWallets {
+		params["app"] = s.client.ClientID
+	}
+
+	if opt.State != "" {
+		params["state"] = string(opt.State)
+	}
+
+	if opt.Asset != "" {
+		params["asset"] = opt.Asset
+	}
+
+	var utxos []*utxo
+	if err := s.client.Get(ctx, "/safe/outputs", params, &utxos); err != nil {
+		return nil, err
+	}
+
+	return utxos, nil
+}
+
+func (s *service) readUtxo(ctx context.Context, hash mixinnet.Hash, index uint8) (*utxo, error) {
+	uri := fmt.Sprintf("/safe/outputs/%s:%d", hash.String(), index)
+
+	var u utxo
+	if err := s.client.Get(ctx, uri, nil, &u); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// decodeMemo decodes the hex encoded extra, it is empty if the extra isn't text
+func decodeMemo(extra string) string {
+	b, err := hex.DecodeString(extra)
+	if err != nil || !utf8.Valid(b) {
+		return ""
+	}
+
+	return string(b)
+}
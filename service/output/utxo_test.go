@@ -0,0 +1,27 @@
+package output
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDecodeMemo(t *testing.T) {
+	testCases := []struct {
+		name  string
+		extra string
+		memo  string
+	}{
+		{"Empty", "", ""},
+		{"Text", hex.EncodeToString([]byte("order 42")), "order 42"},
+		{"Binary", "ff00fe", ""},
+		{"Not hex", "order 42", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if memo := decodeMemo(tc.extra); memo != tc.memo {
+				t.Errorf("expected %q, got %q", tc.memo, memo)
+			}
+		})
+	}
+}
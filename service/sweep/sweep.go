@@ -0,0 +1,358 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/zyedidia/generic/mapset"
+)
+
+type Rule struct {
+	AssetID   string `mapstructure:"asset_id" valid:"uuid,required"`
+	MinAmount string `mapstructure:"min_amount" valid:"required"`
+	// Destination is a mix address, empty for the master wallet
+	Destination string        `mapstructure:"destination"`
+	Interval    time.Duration `mapstructure:"interval" valid:"required"`
+}
+
+type Config struct {
+	ClientID string `valid:"required"`
+	Rules    []Rule `valid:"-"`
+	// ArchiveDestination is the mix address archived wallets are swept to,
+	// optional. The deposits made after a wallet is archived are swept too.
+	ArchiveDestination string
+}
+
+func New(
+	outputs core.OutputStore,
+	transfers core.TransferStore,
+	wallets core.WalletStore,
+	walletz core.WalletService,
+	ledger core.LedgerStore,
+	cfg Config,
+) (core.SweepService, error) {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		return nil, err
+	}
+
+	master := mixin.RequireNewMixAddress([]string{cfg.ClientID}, 1)
+
+	rules := make(map[string]*core.SweepRule, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		if _, err := govalidator.ValidateStruct(r); err != nil {
+			return nil, fmt.Errorf("sweep rule %s: %w", r.AssetID, err)
+		}
+
+		minAmount, err := decimal.NewFromString(r.MinAmount)
+		if err != nil {
+			return nil, fmt.Errorf("sweep rule %s: invalid min amount: %w", r.AssetID, err)
+		}
+
+		rule := &core.SweepRule{
+			AssetID:     r.AssetID,
+			MinAmount:   minAmount,
+			Destination: master,
+			Interval:    r.Interval,
+		}
+
+		if r.Destination != "" {
+			if rule.Destination, err = mixin.MixAddressFromString(r.Destination); err != nil {
+				return nil, fmt.Errorf("sweep rule %s: invalid destination: %w", r.AssetID, err)
+			}
+		}
+
+		rules[rule.AssetID] = rule
+	}
+
+	var archive *mixin.MixAddress
+	if cfg.ArchiveDestination != "" {
+		var err error
+		if archive, err = mixin.MixAddressFromString(cfg.ArchiveDestination); err != nil {
+			return nil, fmt.Errorf("invalid archive destination: %w", err)
+		}
+	}
+
+	return &service{
+		outputs:   outputs,
+		transfers: transfers,
+		wallets:   wallets,
+		walletz:   walletz,
+		ledger:    ledger,
+		rules:     rules,
+		archive:   archive,
+		master:    cfg.ClientID,
+	}, nil
+}
+
+type service struct {
+	outputs   core.OutputStore
+	transfers core.TransferStore
+	wallets   core.WalletStore
+	walletz   core.WalletService
+	ledger    core.LedgerStore
+	rules     map[string]*core.SweepRule
+	archive   *mixin.MixAddress
+	master    string
+}
+
+func (s *service) Rules() []*core.SweepRule {
+	rules := make([]*core.SweepRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func (s *service) ArchiveDestination() *mixin.MixAddress {
+	return s.archive
+}
+
+func (s *service) SweepWallet(ctx context.Context, userID, assetID string) ([]*core.Transfer, error) {
+	if userID == s.master {
+		return nil, fmt.Errorf("%w: master wallet", core.ErrSweepNotAllowed)
+	}
+
+	wallet, err := s.wallets.FindLatest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wallet.Status != core.WalletStatusActive {
+		return nil, fmt.Errorf("%w: wallet is %s", core.ErrSweepNotAllowed, strings.ToLower(wallet.Status.String()))
+	}
+
+	// active wallets listed as inactive hold escrowed funds
+	ids, err := s.wallets.ListInactive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapset.Of(ids...).Has(userID) {
+		return nil, fmt.Errorf("%w: escrow wallet", core.ErrSweepNotAllowed)
+	}
+
+	balances, err := s.outputs.SumBalances(ctx, userID, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sweepBalances(ctx, balances, assetID)
+}
+
+func (s *service) SweepAll(ctx context.Context, assetID string) ([]*core.Transfer, error) {
+	balances, err := s.outputs.SumBalances(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.wallets.ListInactive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inactive := mapset.Of(ids...)
+
+	var active []*core.Balance
+	for _, b := range balances {
+		if b.UserID == s.master || inactive.Has(b.UserID) {
+			continue
+		}
+
+		active = append(active, b)
+	}
+
+	return s.sweepBalances(ctx, active, assetID)
+}
+
+// SweepArchived sweeps the balances of archived wallets to the archive
+// destination whatever their amount. Wallets are swept once they are archived
+// with a zero balance, this moves the deposits made after.
+func (s *service) SweepArchived(ctx context.Context) ([]*core.Transfer, error) {
+	if s.archive == nil {
+		return nil, nil
+	}
+
+	balances, err := s.outputs.SumBalances(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.wallets.ListInactive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inactive := mapset.Of(ids...)
+
+	var (
+		transfers []*core.Transfer
+		errs      []error
+	)
+
+	for _, b := range balances {
+		if !inactive.Has(b.UserID) {
+			continue
+		}
+
+		// archiving is final, the cached status is good enough
+		wallet, err := s.wallets.Find(ctx, b.UserID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("find wallet %s: %w", b.UserID, err))
+			continue
+		}
+
+		if wallet.Status != core.WalletStatusArchived {
+			continue
+		}
+
+		rule := &core.SweepRule{AssetID: b.AssetID, Destination: s.archive}
+		transfer, err := s.sweepBalance(ctx, rule, b, true)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if transfer != nil {
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	return transfers, errors.Join(errs...)
+}
+
+// sweepBalances sweeps the balances matched by the rules. A balance failing
+// to sweep doesn't stop the others, the errors are joined.
+func (s *service) sweepBalances(ctx context.Context, balances []*core.Balance, assetID string) ([]*core.Transfer, error) {
+	var (
+		transfers []*core.Transfer
+		errs      []error
+	)
+
+	for _, b := range balances {
+		if assetID != "" && b.AssetID != assetID {
+			continue
+		}
+
+		rule, ok := s.rules[b.AssetID]
+		if !ok || b.Amount.LessThan(rule.MinAmount) {
+			continue
+		}
+
+		transfer, err := s.sweepBalance(ctx, rule, b, false)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if transfer != nil {
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	return transfers, errors.Join(errs...)
+}
+
+// sweepBalance sweeps the part of the balance not allocated to the wallet's
+// virtual accounts, if it still reaches the rule's min amount
+func (s *service) sweepBalance(ctx context.Context, rule *core.SweepRule, balance *core.Balance, archive bool) (*core.Transfer, error) {
+	chains, err := s.ledger.ListBalances(ctx, balance.UserID, core.AccountChain)
+	if err != nil {
+		return nil, fmt.Errorf("sweep %s %s failed: %w", balance.UserID, balance.AssetID, err)
+	}
+
+	free := *balance
+	for _, chain := range chains {
+		if chain.AssetID == balance.AssetID && chain.Amount.IsNegative() {
+			free.Amount = free.Amount.Add(chain.Amount)
+		}
+	}
+
+	if !free.Amount.IsPositive() || free.Amount.LessThan(rule.MinAmount) {
+		return nil, nil
+	}
+
+	transfer, err := s.sweep(ctx, rule, &free, archive)
+	if err != nil {
+		return nil, fmt.Errorf("sweep %s %s failed: %w", balance.UserID, balance.AssetID, err)
+	}
+
+	return transfer, nil
+}
+
+// sweep assigns the unassigned outputs of the balance to the rule destination.
+// A sweep round starts at the current assign offset, so its trace id is
+// deterministic and concurrent or repeated sweeps of the same round are merged.
+// Archive sweeps share their traces with the sweeps of ArchiveWallet.
+func (s *service) sweep(ctx context.Context, rule *core.SweepRule, balance *core.Balance, archive bool) (*core.Transfer, error) {
+	offset, err := s.transfers.GetAssignOffset(ctx, balance.UserID, balance.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, memo := "sweep", "sweep"
+	if archive {
+		kind, memo = "archive", core.ArchiveSweepMemo
+	}
+
+	round := fmt.Sprintf("%s %s %s from %d", kind, balance.UserID, balance.AssetID, offset)
+	trace := uuid.NewSHA1(uuid.NameSpaceOID, []byte(round))
+
+	if transfer, err := s.transfers.FindTrace(ctx, trace.String()); err == nil {
+		return transfer, nil
+	} else if !store.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	const limit = 256
+	outputs, err := s.outputs.ListTarget(ctx, balance.UserID, balance.AssetID, offset, balance.Amount, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+
+	transfer := &core.Transfer{
+		TraceID:  trace.String(),
+		Status:   core.TransferStatusPending,
+		UserID:   balance.UserID,
+		AssetID:  balance.AssetID,
+		Memo:     memo,
+		Opponent: rule.Destination,
+	}
+
+	if transfer.Internal, err = s.walletz.IsInternal(ctx, rule.Destination); err != nil {
+		return nil, err
+	}
+
+	transfer.AssignRange[0] = outputs[0].Sequence
+	for _, output := range outputs {
+		transfer.Amount = transfer.Amount.Add(output.Amount)
+		transfer.AssignRange[1] = output.Sequence
+	}
+
+	// the allocated part of the outputs goes back to the wallet as change
+	transfer.Amount = decimal.Min(transfer.Amount, balance.Amount)
+
+	// the outputs are capped by limit, the rest is left to the next round
+	if transfer.Amount.LessThan(rule.MinAmount) {
+		return nil, nil
+	}
+
+	if err := s.transfers.Assign(ctx, transfer, offset); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
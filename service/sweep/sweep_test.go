@@ -0,0 +1,144 @@
+package sweep
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	master = "2bb9a2c4-7f1a-4a6e-9d46-6e2d9c7c9b10"
+	asset  = "4d8c508b-91c5-375b-92b0-ee702ed2dac5"
+)
+
+type fakeOutputs struct {
+	core.OutputStore
+	balances []*core.Balance
+}
+
+func (s *fakeOutputs) SumBalances(context.Context, string, string) ([]*core.Balance, error) {
+	return s.balances, nil
+}
+
+// ListTarget returns one output per balance, of the whole balance
+func (s *fakeOutputs) ListTarget(_ context.Context, userID, assetID string, offset uint64, _ decimal.Decimal, _ int) ([]*core.Output, error) {
+	for _, b := range s.balances {
+		if b.UserID == userID && b.AssetID == assetID {
+			return []*core.Output{{Sequence: offset + 1, UserID: userID, AssetID: assetID, Amount: b.Amount}}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type fakeTransfers struct {
+	core.TransferStore
+	failing  string
+	assigned []*core.Transfer
+}
+
+func (s *fakeTransfers) GetAssignOffset(context.Context, string, string) (uint64, error) {
+	return 0, nil
+}
+
+func (s *fakeTransfers) FindTrace(context.Context, string) (*core.Transfer, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (s *fakeTransfers) Assign(_ context.Context, transfer *core.Transfer, _ uint64) error {
+	if transfer.UserID == s.failing {
+		return core.ErrLedgerExceedsBalance
+	}
+
+	s.assigned = append(s.assigned, transfer)
+	return nil
+}
+
+type fakeWallets struct {
+	core.WalletStore
+}
+
+func (s *fakeWallets) ListInactive(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+type fakeWalletz struct {
+	core.WalletService
+}
+
+func (s *fakeWalletz) IsInternal(context.Context, *mixin.MixAddress) (bool, error) {
+	return true, nil
+}
+
+type fakeLedger struct {
+	core.LedgerStore
+	allocated map[string]decimal.Decimal
+}
+
+func (s *fakeLedger) ListBalances(_ context.Context, userID, _ string) ([]*core.AccountBalance, error) {
+	if amount, ok := s.allocated[userID]; ok {
+		return []*core.AccountBalance{{UserID: userID, Account: core.AccountChain, AssetID: asset, Amount: amount.Neg()}}, nil
+	}
+
+	return nil, nil
+}
+
+func TestSweepAll(t *testing.T) {
+	const (
+		failing   = "0b0e2a9d-5f9c-4d0e-8f0b-1c1d7a8e6f01"
+		allocated = "0b0e2a9d-5f9c-4d0e-8f0b-1c1d7a8e6f02"
+		partly    = "0b0e2a9d-5f9c-4d0e-8f0b-1c1d7a8e6f03"
+		free      = "0b0e2a9d-5f9c-4d0e-8f0b-1c1d7a8e6f04"
+	)
+
+	outputs := &fakeOutputs{balances: []*core.Balance{
+		{UserID: failing, AssetID: asset, Amount: decimal.NewFromInt(20)},
+		{UserID: allocated, AssetID: asset, Amount: decimal.NewFromInt(20)},
+		{UserID: partly, AssetID: asset, Amount: decimal.NewFromInt(20)},
+		{UserID: free, AssetID: asset, Amount: decimal.NewFromInt(20)},
+	}}
+	transfers := &fakeTransfers{failing: failing}
+	ledger := &fakeLedger{allocated: map[string]decimal.Decimal{
+		allocated: decimal.NewFromInt(15),
+		partly:    decimal.NewFromInt(5),
+	}}
+
+	sweepz, err := New(outputs, transfers, &fakeWallets{}, &fakeWalletz{}, ledger, Config{
+		ClientID: master,
+		Rules:    []Rule{{AssetID: asset, MinAmount: "10", Interval: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	swept, err := sweepz.SweepAll(context.Background(), "")
+	if !errors.Is(err, core.ErrLedgerExceedsBalance) {
+		t.Fatalf("expected the failing wallet reported, got %v", err)
+	}
+
+	// the failing wallet doesn't stop the ones after it, the allocated funds stay
+	amounts := map[string]decimal.Decimal{}
+	for _, transfer := range swept {
+		amounts[transfer.UserID] = transfer.Amount
+	}
+
+	if len(amounts) != 2 || !amounts[partly].Equal(decimal.NewFromInt(15)) || !amounts[free].Equal(decimal.NewFromInt(20)) {
+		t.Fatalf("expected 15 swept from the partly allocated wallet and 20 from the free one, got %v", amounts)
+	}
+}
+
+func TestNewInvalidArchiveDestination(t *testing.T) {
+	_, err := New(&fakeOutputs{}, &fakeTransfers{}, &fakeWallets{}, &fakeWalletz{}, &fakeLedger{}, Config{
+		ClientID:           master,
+		ArchiveDestination: "MIX3QEeg1WkLrjvjxyvNMkzWaKHn5HMbDm",
+	})
+
+	if err == nil {
+		t.Fatal("expected the invalid archive destination refused")
+	}
+}
@@ -95,6 +95,8 @@ func (s *service) Spend(ctx context.Context, transfer *core.Transfer, outputs []
 		return fmt.Errorf("create transaction request failed: %w", err)
 	}
 
+	transfer.TxHash = req.TransactionHash
+
 	// sign transaction
 	if err := mixin.SafeSignTransaction(tx, s.spendKey, req.Views, 0); err != nil {
 		return fmt.Errorf("sign transaction failed: %w", err)
@@ -3,46 +3,39 @@ package wallet
 import (
 	"context"
 	"crypto/rand"
+	"time"
 
 	"github.com/fox-one/mixin-sdk-go/v2"
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
 )
 
 type service struct {
-	client *mixin.Client
+	client  *mixin.Client
+	wallets core.WalletStore
 }
 
-func New(client *mixin.Client) core.WalletService {
-	return &service{client: client}
+func New(client *mixin.Client, wallets core.WalletStore) core.WalletService {
+	return &service{client: client, wallets: wallets}
 }
 
 func (s *service) Create(ctx context.Context, label string) (*core.Wallet, error) {
+	// generate tip pin & spend key before the user exists, so they can be
+	// persisted along with the keystore
+	pin := mixinnet.GenerateKey(rand.Reader)
+	spendKey := mixinnet.GenerateKey(rand.Reader)
+
 	// create user
 	_, keystore, err := s.client.CreateUser(ctx, mixin.GenerateEd25519Key(), label)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := mixin.NewFromKeystore(keystore)
-	if err != nil {
-		return nil, err
-	}
-
-	// set tip pin
-	pin := mixinnet.GenerateKey(rand.Reader)
-	if err := client.ModifyPin(ctx, "", pin.Public().String()); err != nil {
-		return nil, err
-	}
-
-	// set spend key
-	spendKey := mixinnet.GenerateKey(rand.Reader)
-	if _, err := client.SafeMigrate(ctx, spendKey.String(), pin.String()); err != nil {
-		return nil, err
-	}
-
 	return &core.Wallet{
+		CreatedAt:  time.Now(),
 		UserID:     keystore.ClientID,
+		Status:     core.WalletStatusProvisioning,
 		Label:      label,
 		SessionID:  keystore.SessionID,
 		PrivateKey: keystore.PrivateKey,
@@ -51,3 +44,63 @@ func (s *service) Create(ctx context.Context, label string) (*core.Wallet, error
 		SpendKey:   spendKey.String(),
 	}, nil
 }
+
+func (s *service) Provision(ctx context.Context, wallet *core.Wallet) error {
+	client, err := mixin.NewFromKeystore(&mixin.Keystore{
+		ClientID:   wallet.UserID,
+		SessionID:  wallet.SessionID,
+		PrivateKey: wallet.PrivateKey,
+		PinToken:   wallet.PinToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	pin, err := mixinnet.KeyFromString(wallet.Pin)
+	if err != nil {
+		return err
+	}
+
+	// check which steps are already done by a previous attempt
+	user, err := client.UserMe(ctx)
+	if err != nil {
+		return err
+	}
+
+	// set tip pin
+	if !user.HasPin {
+		if err := client.ModifyPin(ctx, "", pin.Public().String()); err != nil {
+			return err
+		}
+	}
+
+	// set spend key
+	if !user.HasSafe {
+		if _, err := client.SafeMigrate(ctx, wallet.SpendKey, pin.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) IsInternal(ctx context.Context, opponent *mixin.MixAddress) (bool, error) {
+	members := opponent.Members()
+	if opponent.Threshold != 1 || len(members) != 1 {
+		return false, nil
+	}
+
+	if members[0] == s.client.ClientID {
+		return true, nil
+	}
+
+	if _, err := s.wallets.Find(ctx, members[0]); err != nil {
+		if store.IsErrNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
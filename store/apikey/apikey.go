@@ -0,0 +1,146 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.APIKeyStore {
+	return &store{db: db}
+}
+
+type store struct {
+	db *nap.DB
+}
+
+var scanColumns = []string{"id", "created_at", "name", "key_hash", "signing_key", "scopes", "wallets", "revoked_at"}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanKey(scanner scanner, key *core.APIKey) error {
+	var (
+		scopes     pq.StringArray
+		wallets    pq.StringArray
+		revokedAt  sql.NullTime
+		signingKey sql.NullString
+	)
+
+	if err := scanner.Scan(&key.ID, &key.CreatedAt, &key.Name, &key.Hash, &signingKey, &scopes, &wallets, &revokedAt); err != nil {
+		return err
+	}
+
+	key.SigningKey = signingKey.String
+	key.Scopes = scopes
+	key.WalletIDs = wallets
+	key.RevokedAt = revokedAt.Time
+	return nil
+}
+
+func (s *store) Create(ctx context.Context, key *core.APIKey, secret string) error {
+	var wallets, signingKey any
+	if len(key.WalletIDs) > 0 {
+		wallets = pq.StringArray(key.WalletIDs)
+	}
+
+	if key.SigningKey != "" {
+		signingKey = key.SigningKey
+	}
+
+	b := sq.Insert("api_keys").
+		Columns("name", "key_hash", "signing_key", "scopes", "wallets").
+		Values(key.Name, core.HashSecret(secret), signingKey, pq.StringArray(key.Scopes), wallets)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	key.ID = uint64(id)
+	return nil
+}
+
+func (s *store) FindSecret(ctx context.Context, secret string) (*core.APIKey, error) {
+	b := sq.Select(scanColumns...).
+		From("api_keys").
+		Where("key_hash = ? AND revoked_at IS NULL", core.HashSecret(secret))
+
+	var key core.APIKey
+	if err := scanKey(b.RunWith(s.db).QueryRowContext(ctx), &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (s *store) Find(ctx context.Context, id uint64) (*core.APIKey, error) {
+	b := sq.Select(scanColumns...).
+		From("api_keys").
+		Where("id = ? AND revoked_at IS NULL", id)
+
+	var key core.APIKey
+	if err := scanKey(b.RunWith(s.db).QueryRowContext(ctx), &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (s *store) List(ctx context.Context) ([]*core.APIKey, error) {
+	b := sq.Select(scanColumns...).
+		From("api_keys").
+		OrderBy("id")
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var keys []*core.APIKey
+	for rows.Next() {
+		var key core.APIKey
+		if err := scanKey(rows, &key); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (s *store) Revoke(ctx context.Context, id uint64) error {
+	b := sq.Update("api_keys").
+		Set("revoked_at", sq.Expr("NOW()")).
+		Where("id = ? AND revoked_at IS NULL", id)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("api key %d not found or already revoked", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB, key core.AuditKey) core.AuditStore {
+	return &auditStore{db: db, key: key}
+}
+
+type auditStore struct {
+	db  *nap.DB
+	key core.AuditKey
+}
+
+// headID is the row of audit_heads holding the hash of the last event
+const headID = 1
+
+var scanColumns = []string{"id", "created_at", "actor", "action", "target", "params", "outcome", "prev_hash", "hash"}
+
+// Append chains the event to the head of the log. The head row is locked in
+// the insert transaction, so the appends are serialized instead of racing for
+// the same previous event.
+func (s *auditStore) Append(ctx context.Context, event *core.AuditEvent) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	b := sq.Select("hash").
+		From("audit_heads").
+		Where("id = ?", headID).
+		Suffix("FOR UPDATE")
+
+	var prevHash string
+	if err := b.RunWith(tx).QueryRowContext(ctx).Scan(&prevHash); err != nil {
+		return err
+	}
+
+	event.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	event.PrevHash = prevHash
+	event.Hash = event.Digest(s.key)
+
+	r, err := sq.Insert("audit_events").
+		Columns(scanColumns[1:]...).
+		Values(event.CreatedAt, event.Actor, event.Action, event.Target, event.Params, event.Outcome, event.PrevHash, event.Hash).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	u := sq.Update("audit_heads").
+		Set("hash", event.Hash).
+		Where("id = ?", headID)
+	if _, err := u.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	event.ID = uint64(id)
+	return nil
+}
+
+func (s *auditStore) List(ctx context.Context, query core.AuditQuery) ([]*core.AuditEvent, error) {
+	b := sq.Select(scanColumns...).
+		From("audit_events").
+		Where("id > ?", query.Offset).
+		OrderBy("id").
+		Limit(uint64(query.Limit))
+
+	if query.Actor != "" {
+		b = b.Where("actor = ?", query.Actor)
+	}
+
+	if query.Action != "" {
+		b = b.Where("action = ?", query.Action)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var events []*core.AuditEvent
+	for rows.Next() {
+		var event core.AuditEvent
+		if err := rows.Scan(&event.ID, &event.CreatedAt, &event.Actor, &event.Action, &event.Target, &event.Params, &event.Outcome, &event.PrevHash, &event.Hash); err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
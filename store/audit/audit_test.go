@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/storetest"
+)
+
+func TestAppendConcurrent(t *testing.T) {
+	ctx := context.Background()
+	audits := New(storetest.Open(t), core.AuditKey("test"))
+
+	// concurrent appends are chained one after another, none of them fails
+	errs := storetest.Race(16, func(int) error {
+		return audits.Append(ctx, &core.AuditEvent{Actor: "test", Action: "test.append", Outcome: core.AuditOutcomeOK})
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+ALTER TABLE
+    `wallets` DROP INDEX `idx_wallets_request`,
+    DROP INDEX `idx_wallets_status`,
+    DROP COLUMN `request_id`,
+    DROP COLUMN `status`;
@@ -0,0 +1,14 @@
+ALTER TABLE
+    `wallets`
+ADD
+    COLUMN `request_id` char(36) NULL
+AFTER
+    `user_id`,
+ADD
+    COLUMN `status` tinyint NOT NULL DEFAULT 2
+AFTER
+    `request_id`,
+ADD
+    UNIQUE KEY `idx_wallets_request` (`request_id`),
+ADD
+    INDEX `idx_wallets_status` (`status`);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `wallet_pool` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `user_id` char(36) NOT NULL,
+    `claimed_at` datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_wallet_pool_user` (`user_id`),
+    INDEX `idx_wallet_pool_claimed` (`claimed_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,4 @@
+ALTER TABLE
+    `wallets` DROP INDEX `idx_wallets_external`,
+    DROP COLUMN `external_id`,
+    DROP COLUMN `metadata`;
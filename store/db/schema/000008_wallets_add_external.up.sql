@@ -0,0 +1,12 @@
+ALTER TABLE
+    `wallets`
+ADD
+    COLUMN `external_id` varchar(64) NULL
+AFTER
+    `label`,
+ADD
+    COLUMN `metadata` JSON NULL
+AFTER
+    `external_id`,
+ADD
+    UNIQUE KEY `idx_wallets_external` (`external_id`);
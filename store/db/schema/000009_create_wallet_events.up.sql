@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `wallet_events` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `user_id` char(36) NOT NULL,
+    `from_status` tinyint NOT NULL,
+    `to_status` tinyint NOT NULL,
+    `actor` varchar(128) NOT NULL,
+    `reason` varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    INDEX `idx_wallet_events_user` (`user_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
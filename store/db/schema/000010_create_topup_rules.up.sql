@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `topup_rules` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `user_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `floor` decimal(64, 8) NOT NULL,
+    `target` decimal(64, 8) NOT NULL,
+    `refills` bigint NOT NULL DEFAULT 0,
+    `refilled_at` datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_topup_rules_user_asset` (`user_id`, `asset_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
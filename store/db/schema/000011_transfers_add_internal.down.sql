@@ -0,0 +1,6 @@
+ALTER TABLE
+    `transfers` DROP INDEX `idx_transfers_tx_hash`,
+    DROP INDEX `idx_transfers_user_internal`,
+    DROP COLUMN `internal`,
+    DROP COLUMN `tx_hash`,
+    DROP COLUMN `output_sequence`;
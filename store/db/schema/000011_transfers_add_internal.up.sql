@@ -0,0 +1,34 @@
+ALTER TABLE
+    `transfers`
+ADD
+    COLUMN `internal` tinyint(1) NOT NULL DEFAULT 0
+AFTER
+    `threshold`,
+ADD
+    COLUMN `tx_hash` char(64) NULL
+AFTER
+    `output_to`,
+ADD
+    COLUMN `output_sequence` bigint NULL
+AFTER
+    `tx_hash`,
+ADD
+    INDEX `idx_transfers_tx_hash` (`tx_hash`),
+ADD
+    INDEX `idx_transfers_user_internal` (`user_id`, `internal`);
+
+UPDATE
+    `transfers`
+SET
+    `internal` = 1
+WHERE
+    `threshold` = 1
+    AND (
+        `opponents` = CONCAT('{', '{{ .UserID }}', '}')
+        OR `opponents` IN (
+            SELECT
+                CONCAT('{', `user_id`, '}')
+            FROM
+                `wallets`
+        )
+    );
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `api_keys` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `name` varchar(64) NOT NULL,
+    `key_hash` char(64) NOT NULL,
+    `scopes` varchar(256) NOT NULL,
+    `wallets` text NULL,
+    `revoked_at` datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_api_keys_hash` (`key_hash`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `audit_events` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL,
+    `actor` varchar(128) NOT NULL,
+    `action` varchar(64) NOT NULL,
+    `target` varchar(128) NOT NULL DEFAULT '',
+    `params` text NOT NULL,
+    `outcome` varchar(255) NOT NULL,
+    `prev_hash` char(64) NOT NULL,
+    `hash` char(64) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_audit_events_prev` (`prev_hash`),
+    INDEX `idx_audit_events_action` (`action`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
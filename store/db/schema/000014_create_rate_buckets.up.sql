@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `rate_buckets` (
+    `key` varchar(191) NOT NULL,
+    `tokens` double NOT NULL,
+    `updated_at` datetime(6) NOT NULL,
+    PRIMARY KEY (`key`),
+    INDEX `idx_rate_buckets_updated` (`updated_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,3 @@
+ALTER TABLE
+    `transfers` DROP COLUMN `claimed_by`,
+    DROP COLUMN `claim_expires`;
@@ -0,0 +1,10 @@
+ALTER TABLE
+    `transfers`
+ADD
+    COLUMN `claimed_by` varchar(128) NULL
+AFTER
+    `output_sequence`,
+ADD
+    COLUMN `claim_expires` datetime NULL
+AFTER
+    `claimed_by`;
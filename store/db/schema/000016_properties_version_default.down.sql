@@ -0,0 +1,6 @@
+ALTER TABLE
+    `properties`
+ALTER
+    COLUMN `version`
+SET
+    DEFAULT 0;
@@ -0,0 +1,13 @@
+UPDATE
+    `properties`
+SET
+    `version` = 1
+WHERE
+    `version` = 0;
+
+ALTER TABLE
+    `properties`
+ALTER
+    COLUMN `version`
+SET
+    DEFAULT 1;
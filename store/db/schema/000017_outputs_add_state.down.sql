@@ -0,0 +1,6 @@
+ALTER TABLE
+    `outputs` DROP INDEX `idx_outputs_hash_index`,
+    DROP INDEX `idx_outputs_state_spent`,
+    DROP COLUMN `state`,
+    DROP COLUMN `spent_by`,
+    DROP COLUMN `spent_at`;
@@ -0,0 +1,18 @@
+ALTER TABLE
+    `outputs`
+ADD
+    COLUMN `state` tinyint NOT NULL DEFAULT 1
+AFTER
+    `amount`,
+ADD
+    COLUMN `spent_by` char(64) NULL
+AFTER
+    `state`,
+ADD
+    COLUMN `spent_at` datetime NULL
+AFTER
+    `spent_by`,
+ADD
+    UNIQUE INDEX `idx_outputs_hash_index` (`hash`, `index`),
+ADD
+    INDEX `idx_outputs_state_spent` (`state`, `spent_at`);
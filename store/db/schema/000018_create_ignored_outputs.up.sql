@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `ignored_outputs` (
+    `sequence` bigint NOT NULL,
+    `created_at` datetime NOT NULL,
+    `hash` char(64) NOT NULL,
+    `index` tinyint NOT NULL,
+    `user_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `reason` varchar(64) NOT NULL,
+    `ignored_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`sequence`),
+    UNIQUE INDEX `idx_ignored_outputs_hash_index` (`hash`, `index`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
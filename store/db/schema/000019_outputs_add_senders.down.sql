@@ -0,0 +1,13 @@
+ALTER TABLE
+    `outputs` DROP COLUMN `senders`,
+    DROP COLUMN `senders_threshold`,
+    DROP COLUMN `extra`,
+    DROP COLUMN `memo`,
+    DROP COLUMN `inscription_hash`;
+
+ALTER TABLE
+    `ignored_outputs` DROP COLUMN `senders`,
+    DROP COLUMN `senders_threshold`,
+    DROP COLUMN `extra`,
+    DROP COLUMN `memo`,
+    DROP COLUMN `inscription_hash`;
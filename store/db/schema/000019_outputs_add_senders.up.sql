@@ -0,0 +1,45 @@
+ALTER TABLE
+    `outputs`
+ADD
+    COLUMN `senders` text NULL
+AFTER
+    `spent_at`,
+ADD
+    COLUMN `senders_threshold` tinyint NOT NULL DEFAULT 0
+AFTER
+    `senders`,
+ADD
+    COLUMN `extra` text NULL
+AFTER
+    `senders_threshold`,
+ADD
+    COLUMN `memo` text NULL
+AFTER
+    `extra`,
+ADD
+    COLUMN `inscription_hash` char(64) NULL
+AFTER
+    `memo`;
+
+ALTER TABLE
+    `ignored_outputs`
+ADD
+    COLUMN `senders` text NULL
+AFTER
+    `amount`,
+ADD
+    COLUMN `senders_threshold` tinyint NOT NULL DEFAULT 0
+AFTER
+    `senders`,
+ADD
+    COLUMN `extra` text NULL
+AFTER
+    `senders_threshold`,
+ADD
+    COLUMN `memo` text NULL
+AFTER
+    `extra`,
+ADD
+    COLUMN `inscription_hash` char(64) NULL
+AFTER
+    `memo`;
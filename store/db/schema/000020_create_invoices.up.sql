@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS `invoices` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `trace_id` char(36) NOT NULL,
+    `user_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `memo` varchar(200) NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `status` tinyint NOT NULL,
+    `paid` decimal(64, 8) NOT NULL DEFAULT 0,
+    `paid_at` datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_invoices_trace` (`trace_id`),
+    UNIQUE KEY `idx_invoices_user_memo` (`user_id`, `memo`),
+    INDEX `idx_invoices_status_expires` (`status`, `expires_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `invoice_payments` (
+    `output_sequence` bigint NOT NULL,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `invoice_id` bigint NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    PRIMARY KEY (`output_sequence`),
+    INDEX `idx_invoice_payments_invoice` (`invoice_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,3 @@
+ALTER TABLE
+    `transfers` DROP INDEX `idx_transfers_refund_of`,
+    DROP COLUMN `refund_of`;
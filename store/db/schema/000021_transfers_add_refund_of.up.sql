@@ -0,0 +1,8 @@
+ALTER TABLE
+    `transfers`
+ADD
+    COLUMN `refund_of` bigint NOT NULL DEFAULT 0
+AFTER
+    `output_sequence`,
+ADD
+    INDEX `idx_transfers_refund_of` (`refund_of`);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `deposit_routes`;
+
+DROP TABLE IF EXISTS `route_rules`;
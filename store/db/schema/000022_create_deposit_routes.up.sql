@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS `route_rules` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `user_id` char(36) NOT NULL,
+    `priority` int NOT NULL DEFAULT 0,
+    `memo_prefix` varchar(200) NOT NULL DEFAULT '',
+    `memo_regex` varchar(255) NOT NULL DEFAULT '',
+    `sender` varchar(36) NOT NULL DEFAULT '',
+    `asset_id` varchar(36) NOT NULL DEFAULT '',
+    `min_amount` decimal(64, 8) NOT NULL DEFAULT 0,
+    `max_amount` decimal(64, 8) NOT NULL DEFAULT 0,
+    `account` varchar(64) NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_route_rules_user_priority` (`user_id`, `priority`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `deposit_routes` (
+    `output_sequence` bigint NOT NULL,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `user_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `memo` text NULL,
+    `account` varchar(64) NOT NULL DEFAULT '',
+    `rule_id` bigint NOT NULL DEFAULT 0,
+    `operator` varchar(128) NOT NULL DEFAULT '',
+    PRIMARY KEY (`output_sequence`),
+    INDEX `idx_deposit_routes_user_account` (`user_id`, `account`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
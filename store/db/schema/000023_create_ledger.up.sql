@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `ledger_entries` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `trace_id` char(36) NOT NULL,
+    `kind` tinyint NOT NULL,
+    `user_id` char(36) NOT NULL,
+    `account` varchar(64) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `memo` varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_ledger_entries_trace_account` (`trace_id`, `account`),
+    INDEX `idx_ledger_entries_user_account` (`user_id`, `account`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `account_balances` (
+    `user_id` char(36) NOT NULL,
+    `account` varchar(64) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL DEFAULT 0,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`, `asset_id`, `account`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
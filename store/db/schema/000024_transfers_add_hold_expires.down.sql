@@ -0,0 +1,3 @@
+ALTER TABLE
+    `transfers` DROP INDEX `idx_transfers_status_hold_expires`,
+    DROP COLUMN `hold_expires`;
@@ -0,0 +1,8 @@
+ALTER TABLE
+    `transfers`
+ADD
+    COLUMN `hold_expires` datetime NULL
+AFTER
+    `refund_of`,
+ADD
+    INDEX `idx_transfers_status_hold_expires` (`status`, `hold_expires`);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `escrows` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `trace_id` char(36) NOT NULL,
+    `payer_id` char(36) NOT NULL,
+    `wallet_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `memo` varchar(200) NOT NULL DEFAULT '',
+    `payee` varchar(256) NOT NULL,
+    `payee_threshold` tinyint NOT NULL,
+    `arbiter_key_id` bigint NOT NULL,
+    `deadline` datetime NOT NULL,
+    `status` tinyint NOT NULL,
+    `settled_by` varchar(128) NOT NULL DEFAULT '',
+    `settled_at` datetime NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_escrows_trace` (`trace_id`),
+    INDEX `idx_escrows_status_deadline` (`status`, `deadline`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
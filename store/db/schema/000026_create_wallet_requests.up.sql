@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `wallet_requests` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `request_id` char(36) NOT NULL,
+    `external_id` varchar(64) NULL,
+    `reserved_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_wallet_requests_request` (`request_id`),
+    UNIQUE KEY `idx_wallet_requests_external` (`external_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
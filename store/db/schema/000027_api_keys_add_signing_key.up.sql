@@ -0,0 +1,6 @@
+ALTER TABLE
+    `api_keys`
+ADD
+    COLUMN `signing_key` char(64) NULL
+AFTER
+    `key_hash`;
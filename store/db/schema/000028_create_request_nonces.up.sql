@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `request_nonces` (
+    `key_id` bigint NOT NULL,
+    `nonce` varchar(64) NOT NULL,
+    `expires_at` datetime NOT NULL,
+    PRIMARY KEY (`key_id`, `nonce`),
+    INDEX `idx_request_nonces_expires` (`expires_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
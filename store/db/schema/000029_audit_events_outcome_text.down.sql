@@ -0,0 +1,4 @@
+ALTER TABLE
+    `audit_events`
+MODIFY
+    COLUMN `outcome` varchar(255) NOT NULL;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `deposits` (
+    `sequence` bigint NOT NULL,
+    `created_at` datetime NOT NULL,
+    `hash` char(64) NOT NULL,
+    `index` tinyint NOT NULL,
+    `user_id` char(36) NOT NULL,
+    `asset_id` char(36) NOT NULL,
+    `amount` decimal(64, 8) NOT NULL,
+    `senders` text NULL,
+    `senders_threshold` tinyint NOT NULL DEFAULT 0,
+    `extra` text NULL,
+    `memo` text NULL,
+    `inscription_hash` char(64) NULL,
+    `saved_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`sequence`),
+    UNIQUE INDEX `idx_deposits_hash_index` (`hash`, `index`),
+    INDEX `idx_deposits_saved` (`saved_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
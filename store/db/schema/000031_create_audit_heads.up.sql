@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `audit_heads` (
+    `id` tinyint NOT NULL,
+    `hash` char(64) NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+INSERT IGNORE INTO
+    `audit_heads` (`id`, `hash`)
+SELECT
+    1,
+    COALESCE(
+        (
+            SELECT
+                `hash`
+            FROM
+                `audit_events`
+            ORDER BY
+                `id` DESC
+            LIMIT
+                1
+        ), ''
+    );
@@ -0,0 +1,166 @@
+package escrow
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/lib/pq"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.EscrowStore {
+	return &store{db: db}
+}
+
+type store struct {
+	db *nap.DB
+}
+
+var scanColumns = []string{"id", "created_at", "trace_id", "payer_id", "wallet_id", "asset_id", "amount", "memo", "payee", "payee_threshold", "arbiter_key_id", "deadline", "status", "settled_by", "settled_at"}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEscrow(scanner scanner, escrow *core.Escrow) error {
+	var (
+		payee     pq.StringArray
+		threshold uint8
+		settledAt sql.NullTime
+	)
+
+	if err := scanner.Scan(
+		&escrow.ID,
+		&escrow.CreatedAt,
+		&escrow.TraceID,
+		&escrow.PayerID,
+		&escrow.WalletID,
+		&escrow.AssetID,
+		&escrow.Amount,
+		&escrow.Memo,
+		&payee,
+		&threshold,
+		&escrow.ArbiterKeyID,
+		&escrow.Deadline,
+		&escrow.Status,
+		&escrow.SettledBy,
+		&settledAt,
+	); err != nil {
+		return err
+	}
+
+	escrow.Payee = mixin.RequireNewMixAddress(payee, threshold)
+	escrow.SettledAt = settledAt.Time
+	return nil
+}
+
+func (s *store) Create(ctx context.Context, escrow *core.Escrow) error {
+	b := sq.Insert("escrows").
+		Columns("trace_id", "payer_id", "wallet_id", "asset_id", "amount", "memo", "payee", "payee_threshold", "arbiter_key_id", "deadline", "status").
+		Values(escrow.TraceID, escrow.PayerID, escrow.WalletID, escrow.AssetID, escrow.Amount, escrow.Memo, pq.StringArray(escrow.Payee.Members()), escrow.Payee.Threshold, escrow.ArbiterKeyID, escrow.Deadline, escrow.Status)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	escrow.ID = uint64(id)
+	return nil
+}
+
+func (s *store) FindTrace(ctx context.Context, traceID string) (*core.Escrow, error) {
+	b := sq.Select(scanColumns...).
+		From("escrows").
+		Where("trace_id = ?", traceID)
+
+	var escrow core.Escrow
+	if err := scanEscrow(b.RunWith(s.db.Master()).QueryRowContext(ctx), &escrow); err != nil {
+		return nil, err
+	}
+
+	return &escrow, nil
+}
+
+func (s *store) UpdateStatus(ctx context.Context, escrow *core.Escrow, to core.EscrowStatus, actor string) error {
+	b := sq.Update("escrows").
+		Set("status", to).
+		Where("id = ? AND status = ?", escrow.ID, escrow.Status)
+
+	// the actor deciding the escrow is kept, settling only finishes it
+	if escrow.Status == core.EscrowStatusLocked {
+		b = b.Set("settled_by", actor).Set("settled_at", sq.Expr("NOW()"))
+	}
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("optimistic lock failed")
+	}
+
+	if escrow.Status == core.EscrowStatusLocked {
+		escrow.SettledBy = actor
+		escrow.SettledAt = time.Now()
+	}
+
+	escrow.Status = to
+	return nil
+}
+
+func (s *store) ListStatus(ctx context.Context, status core.EscrowStatus, limit int) ([]*core.Escrow, error) {
+	b := sq.Select(scanColumns...).
+		From("escrows").
+		Where("status = ?", status).
+		OrderBy("id").
+		Limit(uint64(limit))
+
+	return s.list(ctx, b)
+}
+
+func (s *store) ListExpired(ctx context.Context, before time.Time, limit int) ([]*core.Escrow, error) {
+	b := sq.Select(scanColumns...).
+		From("escrows").
+		Where("status = ? AND deadline < ?", core.EscrowStatusLocked, before).
+		OrderBy("deadline").
+		Limit(uint64(limit))
+
+	return s.list(ctx, b)
+}
+
+func (s *store) list(ctx context.Context, b sq.SelectBuilder) ([]*core.Escrow, error) {
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var escrows []*core.Escrow
+	for rows.Next() {
+		var escrow core.Escrow
+		if err := scanEscrow(rows, &escrow); err != nil {
+			return nil, err
+		}
+
+		escrows = append(escrows, &escrow)
+	}
+
+	return escrows, rows.Err()
+}
@@ -0,0 +1,133 @@
+package escrow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/storetest"
+	"github.com/pandodao/safe-wallet/store/wallet"
+	"github.com/shopspring/decimal"
+)
+
+func newEscrow() *core.Escrow {
+	return &core.Escrow{
+		TraceID:      uuid.NewString(),
+		PayerID:      uuid.NewString(),
+		WalletID:     uuid.NewString(),
+		AssetID:      uuid.NewString(),
+		Amount:       decimal.NewFromInt(1),
+		Payee:        mixin.RequireNewMixAddress([]string{uuid.NewString()}, 1),
+		ArbiterKeyID: 1,
+		Deadline:     time.Now().Add(time.Hour),
+		Status:       core.EscrowStatusLocked,
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	escrows := New(storetest.Open(t))
+
+	escrow := newEscrow()
+	if err := escrows.Create(ctx, escrow); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := *escrow
+	if err := escrows.UpdateStatus(ctx, escrow, core.EscrowStatusReleasing, "apikey:1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the escrow is decided once, a copy read before can't decide it again
+	if err := escrows.UpdateStatus(ctx, &stale, core.EscrowStatusRefunding, "apikey:2"); err == nil {
+		t.Fatal("expected the stale update to fail")
+	}
+
+	if err := escrows.UpdateStatus(ctx, escrow, core.EscrowStatusReleased, "escrower"); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := escrows.FindTrace(ctx, escrow.TraceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// settling keeps the actor who decided the escrow
+	if found.Status != core.EscrowStatusReleased || found.SettledBy != "apikey:1" || found.SettledAt.IsZero() {
+		t.Fatalf("expected released by apikey:1, got %s by %q", found.Status, found.SettledBy)
+	}
+}
+
+func TestUpdateStatusConcurrent(t *testing.T) {
+	ctx := context.Background()
+	escrows := New(storetest.Open(t))
+
+	escrow := newEscrow()
+	if err := escrows.Create(ctx, escrow); err != nil {
+		t.Fatal(err)
+	}
+
+	// releases & refunds race on the locked escrow, only one decides it
+	actor := func(i int) string {
+		if i%2 == 1 {
+			return fmt.Sprintf("refund:%d", i)
+		}
+
+		return fmt.Sprintf("release:%d", i)
+	}
+
+	errs := storetest.Race(8, func(i int) error {
+		e, decision := *escrow, core.EscrowStatusReleasing
+		if i%2 == 1 {
+			decision = core.EscrowStatusRefunding
+		}
+
+		return escrows.UpdateStatus(ctx, &e, decision, actor(i))
+	})
+
+	var winners []string
+	for i, err := range errs {
+		if err == nil {
+			winners = append(winners, actor(i))
+		}
+	}
+
+	if len(winners) != 1 {
+		t.Fatalf("expected one decision, got %v", winners)
+	}
+
+	found, err := escrows.FindTrace(ctx, escrow.TraceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found.SettledBy != winners[0] {
+		t.Fatalf("expected the escrow decided by %s, got %s", winners[0], found.SettledBy)
+	}
+}
+
+func TestEscrowWalletsInactive(t *testing.T) {
+	ctx := context.Background()
+	db := storetest.Open(t)
+
+	escrow := newEscrow()
+	if err := New(db).Create(ctx, escrow); err != nil {
+		t.Fatal(err)
+	}
+
+	// escrow wallets are never swept or topped up
+	ids, err := wallet.New(db, bytes.Repeat([]byte{1}, 32)).ListInactive(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Contains(ids, escrow.WalletID) {
+		t.Fatal("expected the escrow wallet inactive")
+	}
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// NowMillis is evaluated by the database, so the holders' clocks don't matter
+const NowMillis = "ROUND(UNIX_TIMESTAMP(NOW(3)) * 1000)"
+
+// LeaseKey is the property keeping the lease, its version column is the fencing token
+func LeaseKey(name string) string {
+	return "lease:" + name
+}
+
+// Fence checks the lease the context runs under in the transaction, it's a no-op
+// without one. The lease row stays share locked until the transaction ends, so
+// another holder can't take the lease over before the writes are committed.
+func Fence(ctx context.Context, tx *sql.Tx) error {
+	if lease := core.LeaseFrom(ctx); lease != nil {
+		return LockLease(ctx, tx, lease)
+	}
+
+	return nil
+}
+
+// LockLease share locks the lease row, it fails with core.ErrLeaseLost if the
+// lease expired or changed hands
+func LockLease(ctx context.Context, tx *sql.Tx, lease *core.Lease) error {
+	var (
+		token  uint64
+		holder string
+		alive  bool
+	)
+
+	if err := tx.QueryRowContext(ctx,
+		"SELECT `version`, JSON_UNQUOTE(JSON_EXTRACT(`value`, '$.holder')), JSON_EXTRACT(`value`, '$.expires_at') > "+NowMillis+" "+
+			"FROM `properties` WHERE `key` = ? LOCK IN SHARE MODE",
+		LeaseKey(lease.Name),
+	).Scan(&token, &holder, &alive); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return core.ErrLeaseLost
+		}
+
+		return err
+	}
+
+	if token != lease.Token || holder != lease.Holder || !alive {
+		return core.ErrLeaseLost
+	}
+
+	return nil
+}
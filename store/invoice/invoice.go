@@ -0,0 +1,202 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.InvoiceStore {
+	return &invoiceStore{db: db}
+}
+
+type invoiceStore struct {
+	db *nap.DB
+}
+
+var scanColumns = []string{"id", "created_at", "trace_id", "user_id", "asset_id", "amount", "memo", "expires_at", "status", "paid", "paid_at"}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanInvoice(scanner scanner, invoice *core.Invoice) error {
+	var paidAt sql.NullTime
+
+	if err := scanner.Scan(
+		&invoice.ID,
+		&invoice.CreatedAt,
+		&invoice.TraceID,
+		&invoice.UserID,
+		&invoice.AssetID,
+		&invoice.Amount,
+		&invoice.Memo,
+		&invoice.ExpiresAt,
+		&invoice.Status,
+		&invoice.Paid,
+		&paidAt,
+	); err != nil {
+		return err
+	}
+
+	invoice.PaidAt = paidAt.Time
+	return nil
+}
+
+func (s *invoiceStore) Create(ctx context.Context, invoice *core.Invoice) error {
+	b := sq.Insert("invoices").
+		Columns("trace_id", "user_id", "asset_id", "amount", "memo", "expires_at", "status").
+		Values(invoice.TraceID, invoice.UserID, invoice.AssetID, invoice.Amount, invoice.Memo, invoice.ExpiresAt, invoice.Status)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	invoice.ID = uint64(id)
+	return nil
+}
+
+func (s *invoiceStore) find(ctx context.Context, r sq.BaseRunner, pred interface{}, args ...interface{}) (*core.Invoice, error) {
+	b := sq.Select(scanColumns...).
+		From("invoices").
+		Where(pred, args...)
+
+	var invoice core.Invoice
+	if err := scanInvoice(b.RunWith(r).QueryRowContext(ctx), &invoice); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+func (s *invoiceStore) FindTrace(ctx context.Context, traceID string) (*core.Invoice, error) {
+	return s.find(ctx, s.db, "trace_id = ?", traceID)
+}
+
+func (s *invoiceStore) FindMemo(ctx context.Context, userID, memo string) (*core.Invoice, error) {
+	return s.find(ctx, s.db, "user_id = ? AND memo = ?", userID, memo)
+}
+
+func (s *invoiceStore) List(ctx context.Context, query core.InvoiceQuery) ([]*core.Invoice, error) {
+	b := sq.Select(scanColumns...).
+		From("invoices").
+		Where("id > ?", query.Offset).
+		OrderBy("id").
+		Limit(uint64(query.Limit))
+
+	if query.UserID != "" {
+		b = b.Where("user_id = ?", query.UserID)
+	}
+
+	if query.Status > 0 {
+		b = b.Where("status = ?", query.Status)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var invoices []*core.Invoice
+	for rows.Next() {
+		var invoice core.Invoice
+		if err := scanInvoice(rows, &invoice); err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, &invoice)
+	}
+
+	return invoices, rows.Err()
+}
+
+// Pay locks the invoice, so concurrent payments are added up in order
+func (s *invoiceStore) Pay(ctx context.Context, invoice *core.Invoice, output *core.Output) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return err
+	}
+
+	b := sq.Insert("invoice_payments").
+		Options("IGNORE").
+		Columns("output_sequence", "invoice_id", "amount").
+		Values(output.Sequence, invoice.ID, output.Amount)
+
+	r, err := b.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := r.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		// credited already
+		return nil
+	}
+
+	locked, err := s.find(ctx, tx, sq.Expr("id = ? FOR UPDATE", invoice.ID))
+	if err != nil {
+		return err
+	}
+
+	locked.Receive(output.Amount, output.CreatedAt)
+
+	u := sq.Update("invoices").
+		Set("paid", locked.Paid).
+		Set("paid_at", locked.PaidAt).
+		Set("status", locked.Status).
+		Where("id = ?", locked.ID)
+	if _, err := u.RunWith(tx).ExecContext(ctx); err != nil {
+		return fmt.Errorf("update invoice failed: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*invoice = *locked
+	return nil
+}
+
+func (s *invoiceStore) Expire(ctx context.Context, before time.Time) (int64, error) {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	b := sq.Update("invoices").
+		Set("status", core.InvoiceStatusExpired).
+		Where(sq.Eq{"status": []core.InvoiceStatus{core.InvoiceStatusUnpaid, core.InvoiceStatusPartiallyPaid}}).
+		Where("expires_at < ?", before)
+
+	r, err := b.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
@@ -0,0 +1,93 @@
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/tsenart/nap"
+)
+
+// New keeps the leases in the properties table, the version column is the fencing token
+func New(db *nap.DB) core.LeaseStore {
+	return &leaseStore{db: db}
+}
+
+type leaseStore struct {
+	db *nap.DB
+}
+
+type value struct {
+	Holder    string `json:"holder"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+func (s *leaseStore) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (*core.Lease, error) {
+	key := store.LeaseKey(name)
+
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO `properties` (`key`, `value`) VALUES (?, JSON_OBJECT('holder', '', 'expires_at', 0)) ON DUPLICATE KEY UPDATE `key` = `key`",
+		key,
+	); err != nil {
+		return nil, fmt.Errorf("failed to init lease: %w", err)
+	}
+
+	// assignments are evaluated from left to right, the token is bumped before the holder is replaced
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE `properties` SET "+
+			"`version` = IF(JSON_UNQUOTE(JSON_EXTRACT(`value`, '$.holder')) = ?, `version`, `version` + 1), "+
+			"`value` = JSON_OBJECT('holder', ?, 'expires_at', "+store.NowMillis+" + ?) "+
+			"WHERE `key` = ? AND (JSON_UNQUOTE(JSON_EXTRACT(`value`, '$.holder')) = ? OR JSON_EXTRACT(`value`, '$.expires_at') < "+store.NowMillis+")",
+		holder, holder, ttl.Milliseconds(), key, holder,
+	); err != nil {
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	var (
+		raw   []byte
+		token uint64
+	)
+
+	if err := tx.QueryRowContext(ctx, "SELECT `value`, `version` FROM `properties` WHERE `key` = ?", key).Scan(&raw, &token); err != nil {
+		return nil, err
+	}
+
+	var v value
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lease: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if v.Holder != holder {
+		return nil, core.ErrLeaseHeld
+	}
+
+	return &core.Lease{
+		Name:      name,
+		Holder:    holder,
+		Token:     token,
+		ExpiresAt: time.UnixMilli(v.ExpiresAt),
+	}, nil
+}
+
+// Release expires the lease but keeps the holder, re-acquiring it without a
+// new holder in between keeps the token.
+func (s *leaseStore) Release(ctx context.Context, lease *core.Lease) error {
+	_, err := s.db.ExecContext(ctx,
+		"UPDATE `properties` SET `value` = JSON_SET(`value`, '$.expires_at', 0) "+
+			"WHERE `key` = ? AND `version` = ? AND JSON_UNQUOTE(JSON_EXTRACT(`value`, '$.holder')) = ?",
+		store.LeaseKey(lease.Name), lease.Token, lease.Holder,
+	)
+
+	return err
+}
@@ -0,0 +1,64 @@
+package lease
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/pandodao/safe-wallet/store/property"
+	"github.com/pandodao/safe-wallet/store/storetest"
+)
+
+func TestFence(t *testing.T) {
+	db := storetest.Open(t)
+	leases := New(db)
+	ctx := context.Background()
+	name := uuid.NewString()
+
+	fence := func(ctx context.Context) error {
+		tx, err := db.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer tx.Rollback()
+		return store.Fence(ctx, tx)
+	}
+
+	if err := fence(ctx); err != nil {
+		t.Fatalf("fence without a lease: %v", err)
+	}
+
+	lease, err := leases.Acquire(ctx, name, "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fence(core.WithLease(ctx, lease)); err != nil {
+		t.Fatalf("fence of the held lease: %v", err)
+	}
+
+	if _, err := leases.Acquire(ctx, name, "b", time.Minute); !errors.Is(err, core.ErrLeaseHeld) {
+		t.Fatalf("acquire a held lease: %v", err)
+	}
+
+	if err := leases.Release(ctx, lease); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := leases.Acquire(ctx, name, "b", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fence(core.WithLease(ctx, lease)); !errors.Is(err, core.ErrLeaseLost) {
+		t.Fatalf("fence of a lease taken over: %v", err)
+	}
+
+	if _, err := property.New(db).CompareAndSet(core.WithLease(ctx, lease), "test:"+name, 0, 1); !errors.Is(err, core.ErrLeaseLost) {
+		t.Fatalf("fenced write of a lease taken over: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+// LockChain locks the chain side of the wallet's ledger in the asset and
+// returns its balance, minus the wallet's virtual balances. Postings and
+// spends of the wallet in the asset are serialized by it. The row is created
+// up front, so the first postings wait on the row lock instead of deadlocking
+// on the gap.
+func LockChain(ctx context.Context, tx *sql.Tx, userID, assetID string) (decimal.Decimal, error) {
+	u := sq.Insert("account_balances").
+		Columns("user_id", "account", "asset_id").
+		Values(userID, core.AccountChain, assetID).
+		Suffix("ON DUPLICATE KEY UPDATE `amount` = `amount`")
+	if _, err := u.RunWith(tx).ExecContext(ctx); err != nil {
+		return decimal.Zero, err
+	}
+
+	b := sq.Select("amount").
+		From("account_balances").
+		Where("user_id = ? AND account = ? AND asset_id = ?", userID, core.AccountChain, assetID).
+		Suffix("FOR UPDATE")
+
+	var amount decimal.Decimal
+	err := b.RunWith(tx).QueryRowContext(ctx).Scan(&amount)
+	return amount, err
+}
+
+// SumBacking is the on-chain balance backing the wallet's virtual balances:
+// the unspent and unassigned outputs, plus the transfers of the wallet to
+// itself, like merges, whose outputs aren't synced yet
+func SumBacking(ctx context.Context, tx *sql.Tx, userID, assetID string) (decimal.Decimal, error) {
+	outputs := sq.Select("COALESCE(SUM(outputs.amount), 0)").
+		From("outputs").
+		LeftJoin("assigns ON outputs.asset_id = assigns.asset_id AND outputs.user_id = assigns.user_id").
+		Where("outputs.sequence > COALESCE(assigns.offset,0)").
+		Where("outputs.state = ?", core.OutputStateUnspent).
+		Where("outputs.user_id = ? AND outputs.asset_id = ?", userID, assetID)
+
+	var unspent decimal.Decimal
+	if err := outputs.RunWith(tx).QueryRowContext(ctx).Scan(&unspent); err != nil {
+		return decimal.Zero, err
+	}
+
+	transfers := sq.Select("COALESCE(SUM(amount), 0)").
+		From("transfers").
+		Where("user_id = ? AND internal = ? AND asset_id = ?", userID, true, assetID).
+		Where("opponents = ? AND threshold = 1", pq.StringArray{userID}).
+		Where(sq.Eq{"status": []core.TransferStatus{core.TransferStatusAssigned, core.TransferStatusHandled}}).
+		Where("output_sequence IS NULL").
+		Where("(tx_hash IS NULL OR NOT EXISTS (SELECT 1 FROM outputs WHERE outputs.hash = transfers.tx_hash))")
+
+	var inflight decimal.Decimal
+	if err := transfers.RunWith(tx).QueryRowContext(ctx).Scan(&inflight); err != nil {
+		return decimal.Zero, err
+	}
+
+	return unspent.Add(inflight), nil
+}
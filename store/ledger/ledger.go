@@ -0,0 +1,213 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.LedgerStore {
+	return &ledgerStore{db: db}
+}
+
+type ledgerStore struct {
+	db *nap.DB
+}
+
+var entryColumns = []string{"id", "created_at", "trace_id", "kind", "user_id", "account", "asset_id", "amount", "memo"}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEntry(scanner scanner, entry *core.LedgerEntry) error {
+	return scanner.Scan(
+		&entry.ID,
+		&entry.CreatedAt,
+		&entry.TraceID,
+		&entry.Kind,
+		&entry.UserID,
+		&entry.Account,
+		&entry.AssetID,
+		&entry.Amount,
+		&entry.Memo,
+	)
+}
+
+func (s *ledgerStore) Post(ctx context.Context, posting *core.Posting) (bool, error) {
+	if err := posting.Validate(); err != nil {
+		return false, err
+	}
+
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return false, err
+	}
+
+	// the chain row serializes the postings of the wallet in the asset
+	chain, err := LockChain(ctx, tx, posting.UserID, posting.AssetID)
+	if err != nil {
+		return false, err
+	}
+
+	b := sq.Insert("ledger_entries").
+		Columns("trace_id", "kind", "user_id", "account", "asset_id", "amount", "memo")
+	for _, e := range posting.Entries {
+		b = b.Values(posting.TraceID, posting.Kind, posting.UserID, e.Account, posting.AssetID, e.Amount, posting.Memo)
+	}
+
+	if _, err := b.RunWith(tx).ExecContext(ctx); err != nil {
+		if store.IsErrDuplicate(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	var chainDelta decimal.Decimal
+	for _, e := range posting.Entries {
+		u := sq.Insert("account_balances").
+			Columns("user_id", "account", "asset_id", "amount").
+			Values(posting.UserID, e.Account, posting.AssetID, e.Amount).
+			Suffix("ON DUPLICATE KEY UPDATE `amount` = `amount` + VALUES(`amount`)")
+		if _, err := u.RunWith(tx).ExecContext(ctx); err != nil {
+			return false, err
+		}
+
+		if e.Account == core.AccountChain {
+			chainDelta = chainDelta.Add(e.Amount)
+			continue
+		}
+
+		if e.Amount.IsNegative() {
+			balance, err := findBalance(ctx, tx, posting.UserID, e.Account, posting.AssetID)
+			if err != nil {
+				return false, err
+			}
+
+			if balance.IsNegative() {
+				return false, core.ErrInsufficientAccountBalance
+			}
+		}
+	}
+
+	// the virtual balances only grow when the chain side goes down, reversals
+	// restore balances that were backed before
+	if chainDelta.IsNegative() && posting.Kind != core.PostingKindReversal {
+		virtual := chain.Add(chainDelta).Neg()
+		onChain, err := SumBacking(ctx, tx, posting.UserID, posting.AssetID)
+		if err != nil {
+			return false, err
+		}
+
+		if virtual.GreaterThan(onChain) {
+			return false, core.ErrLedgerExceedsBalance
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func findBalance(ctx context.Context, tx *sql.Tx, userID, account, assetID string) (decimal.Decimal, error) {
+	b := sq.Select("amount").
+		From("account_balances").
+		Where("user_id = ? AND account = ? AND asset_id = ?", userID, account, assetID)
+
+	var amount decimal.Decimal
+	err := b.RunWith(tx).QueryRowContext(ctx).Scan(&amount)
+	return amount, err
+}
+
+func (s *ledgerStore) FindPosting(ctx context.Context, traceID string) ([]*core.LedgerEntry, error) {
+	b := sq.Select(entryColumns...).
+		From("ledger_entries").
+		Where("trace_id = ?", traceID).
+		OrderBy("id")
+
+	return s.listEntries(ctx, s.db.Master(), b)
+}
+
+func (s *ledgerStore) ListEntries(ctx context.Context, query core.LedgerQuery) ([]*core.LedgerEntry, error) {
+	b := sq.Select(entryColumns...).
+		From("ledger_entries").
+		Where("id > ?", query.Offset).
+		OrderBy("id").
+		Limit(uint64(query.Limit))
+
+	if query.UserID != "" {
+		b = b.Where("user_id = ?", query.UserID)
+	}
+
+	if query.Account != "" {
+		b = b.Where("account = ?", query.Account)
+	}
+
+	if query.AssetID != "" {
+		b = b.Where("asset_id = ?", query.AssetID)
+	}
+
+	return s.listEntries(ctx, s.db, b)
+}
+
+func (s *ledgerStore) listEntries(ctx context.Context, r sq.BaseRunner, b sq.SelectBuilder) ([]*core.LedgerEntry, error) {
+	rows, err := b.RunWith(r).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var entries []*core.LedgerEntry
+	for rows.Next() {
+		var entry core.LedgerEntry
+		if err := scanEntry(rows, &entry); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (s *ledgerStore) ListBalances(ctx context.Context, userID, account string) ([]*core.AccountBalance, error) {
+	b := sq.Select("user_id", "account", "asset_id", "amount", "updated_at").
+		From("account_balances").
+		Where("user_id = ?", userID).
+		OrderBy("account", "asset_id")
+
+	if account != "" {
+		b = b.Where("account = ?", account)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var balances []*core.AccountBalance
+	for rows.Next() {
+		var balance core.AccountBalance
+		if err := rows.Scan(&balance.UserID, &balance.Account, &balance.AssetID, &balance.Amount, &balance.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, &balance)
+	}
+
+	return balances, rows.Err()
+}
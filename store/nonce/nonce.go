@@ -0,0 +1,50 @@
+package nonce
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.NonceStore {
+	return &nonceStore{db: db}
+}
+
+type nonceStore struct {
+	db *nap.DB
+}
+
+// Use inserts the nonce, the primary key rejects a reused one on every replica
+func (s *nonceStore) Use(ctx context.Context, keyID uint64, nonce string, expires time.Time) (bool, error) {
+	b := sq.Insert("request_nonces").
+		Columns("key_id", "nonce", "expires_at").
+		Values(keyID, nonce, expires.UTC())
+
+	if _, err := b.RunWith(s.db).ExecContext(ctx); err != nil {
+		if store.IsErrDuplicate(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *nonceStore) DeleteExpired(ctx context.Context, limit int) (int64, error) {
+	b := sq.Delete("request_nonces").
+		Where("expires_at < ?", time.Now().UTC()).
+		OrderBy("expires_at").
+		Limit(uint64(limit))
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
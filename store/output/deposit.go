@@ -0,0 +1,101 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+var depositColumns = []string{
+	"sequence",
+	"created_at",
+	"hash",
+	"`index`",
+	"user_id",
+	"asset_id",
+	"amount",
+	"senders",
+	"senders_threshold",
+	"extra",
+	"memo",
+	"inscription_hash",
+}
+
+func scanDeposit(scanner scanner, output *core.Output) error {
+	var (
+		hash string
+		meta metadata
+	)
+
+	dest := []interface{}{
+		&output.Sequence,
+		&output.CreatedAt,
+		&hash,
+		&output.Index,
+		&output.UserID,
+		&output.AssetID,
+		&output.Amount,
+	}
+
+	if err := scanner.Scan(append(dest, meta.dest(output)...)...); err != nil {
+		return err
+	}
+
+	meta.apply(output)
+
+	output.Hash = generic.Must(mixinnet.HashFromString(hash))
+	return nil
+}
+
+func saveDeposits(ctx context.Context, runner sq.BaseRunner, outputs []*core.Output) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	b := sq.Insert("deposits").
+		Options("IGNORE").
+		Columns(depositColumns...)
+
+	for _, output := range outputs {
+		values := []interface{}{output.Sequence, output.CreatedAt, output.Hash.String(), output.Index, output.UserID, output.AssetID, output.Amount}
+		b = b.Values(append(values, metadataValues(output)...)...)
+	}
+
+	_, err := b.RunWith(runner).ExecContext(ctx)
+	return err
+}
+
+func (s *store) SaveDeposits(ctx context.Context, outputs []*core.Output) error {
+	return saveDeposits(ctx, s.db, outputs)
+}
+
+func (s *store) FindDeposit(ctx context.Context, sequence uint64) (*core.Output, error) {
+	b := sq.Select(depositColumns...).
+		From("deposits").
+		Where("sequence = ?", sequence)
+
+	var output core.Output
+	if err := scanDeposit(b.RunWith(s.db).QueryRowContext(ctx), &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func (s *store) DeleteDeposits(ctx context.Context, before time.Time, limit int) (int64, error) {
+	b := sq.Delete("deposits").
+		Where("saved_at < ?", before).
+		OrderBy("saved_at").
+		Limit(uint64(limit))
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
@@ -0,0 +1,131 @@
+package output
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+var ignoredColumns = []string{
+	"sequence",
+	"created_at",
+	"hash",
+	"`index`",
+	"user_id",
+	"asset_id",
+	"amount",
+	"senders",
+	"senders_threshold",
+	"extra",
+	"memo",
+	"inscription_hash",
+	"reason",
+	"ignored_at",
+}
+
+func scanIgnoredOutput(scanner scanner, output *core.IgnoredOutput) error {
+	var (
+		hash string
+		meta metadata
+	)
+
+	dest := []interface{}{
+		&output.Sequence,
+		&output.CreatedAt,
+		&hash,
+		&output.Index,
+		&output.UserID,
+		&output.AssetID,
+		&output.Amount,
+	}
+
+	dest = append(dest, meta.dest(&output.Output)...)
+	if err := scanner.Scan(append(dest, &output.Reason, &output.IgnoredAt)...); err != nil {
+		return err
+	}
+
+	meta.apply(&output.Output)
+
+	output.Hash = generic.Must(mixinnet.HashFromString(hash))
+	output.State = core.OutputStateUnspent
+	return nil
+}
+
+func (s *store) Ignore(ctx context.Context, outputs []*core.IgnoredOutput) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	b := sq.Insert("ignored_outputs").
+		Options("IGNORE").
+		Columns(ignoredColumns[:len(ignoredColumns)-1]...)
+
+	for _, output := range outputs {
+		values := []interface{}{output.Sequence, output.CreatedAt, output.Hash.String(), output.Index, output.UserID, output.AssetID, output.Amount}
+		values = append(values, metadataValues(&output.Output)...)
+		b = b.Values(append(values, output.Reason)...)
+	}
+
+	_, err := b.RunWith(s.db).ExecContext(ctx)
+	return err
+}
+
+func (s *store) ListIgnored(ctx context.Context, offset uint64, limit int) ([]*core.IgnoredOutput, error) {
+	b := sq.Select(ignoredColumns...).
+		From("ignored_outputs").
+		Where("sequence >= ?", offset).
+		OrderBy("sequence").
+		Limit(uint64(limit))
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var outputs []*core.IgnoredOutput
+	for rows.Next() {
+		var output core.IgnoredOutput
+		if err := scanIgnoredOutput(rows, &output); err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, &output)
+	}
+
+	return outputs, rows.Err()
+}
+
+func (s *store) Release(ctx context.Context, sequence uint64) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	b := sq.Select(ignoredColumns...).
+		From("ignored_outputs").
+		Where("sequence = ?", sequence).
+		Suffix("FOR UPDATE")
+
+	var output core.IgnoredOutput
+	if err := scanIgnoredOutput(b.RunWith(tx).QueryRowContext(ctx), &output); err != nil {
+		return err
+	}
+
+	if _, err := saveOutput(ctx, tx, &output.Output); err != nil {
+		return err
+	}
+
+	// only deposits are filtered, the released output is refundable
+	if err := saveDeposits(ctx, tx, []*core.Output{&output.Output}); err != nil {
+		return err
+	}
+
+	if _, err := sq.Delete("ignored_outputs").Where("sequence = ?", sequence).RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
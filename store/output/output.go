@@ -3,6 +3,9 @@ package output
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/pandodao/generic"
@@ -19,27 +22,98 @@ type store struct {
 	db *nap.DB
 }
 
-func saveOutput(ctx context.Context, tx *sql.Tx, output *core.Output) error {
+// saveOutput keeps the sequence the output is first saved with, assign ranges
+// refer to it. Later sequences of the output only move its state forward.
+//
+// A new output is saved after all the stored ones, outputs released from the
+// ignored outputs or backfilled from an old sequence take the next sequence,
+// otherwise they'd stay below the assign offsets & worker cursors forever.
+// The output's sequence is set to the stored one.
+func saveOutput(ctx context.Context, tx *sql.Tx, output *core.Output) (bool, error) {
+	var stored uint64
+	err := sq.Select("sequence").
+		From("outputs").
+		Where("hash = ? AND `index` = ?", output.Hash.String(), output.Index).
+		Suffix("FOR UPDATE").
+		RunWith(tx).QueryRowContext(ctx).Scan(&stored)
+
+	switch {
+	case err == nil:
+		output.Sequence = stored
+	case errors.Is(err, sql.ErrNoRows):
+		var last uint64
+		if err := sq.Select("sequence").
+			From("outputs").
+			OrderBy("sequence DESC").
+			Limit(1).
+			Suffix("FOR UPDATE").
+			RunWith(tx).QueryRowContext(ctx).Scan(&last); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+
+		if output.Sequence <= last {
+			output.Sequence = last + 1
+		}
+	default:
+		return false, err
+	}
+
+	var (
+		spentBy sql.NullString
+		spentAt sql.NullTime
+	)
+
+	if output.SpentBy != "" {
+		spentBy = sql.NullString{String: output.SpentBy, Valid: true}
+	}
+
+	if !output.SpentAt.IsZero() {
+		spentAt = sql.NullTime{Time: output.SpentAt, Valid: true}
+	}
+
+	values := []interface{}{output.Sequence, output.CreatedAt, output.Hash.String(), output.Index, output.UserID, output.AssetID, output.Amount, output.State, spentBy, spentAt}
+
+	// the metadata of outputs saved before it was synced is filled by a backfill
 	b := sq.Insert("outputs").
-		Options("IGNORE").
-		Columns("sequence", "created_at", "hash", "`index`", "user_id", "asset_id", "amount").
-		Values(output.Sequence, output.CreatedAt, output.Hash.String(), output.Index, output.UserID, output.AssetID, output.Amount)
+		Columns(scanColumns...).
+		Values(append(values, metadataValues(output)...)...).
+		Suffix("ON DUPLICATE KEY UPDATE " +
+			"spent_by = IF(VALUES(state) >= state, COALESCE(VALUES(spent_by), spent_by), spent_by), " +
+			"spent_at = IF(VALUES(state) >= state, COALESCE(VALUES(spent_at), spent_at), spent_at), " +
+			"state = GREATEST(state, VALUES(state)), " +
+			"senders = COALESCE(senders, VALUES(senders)), " +
+			"senders_threshold = IF(senders_threshold = 0, VALUES(senders_threshold), senders_threshold), " +
+			"extra = COALESCE(extra, VALUES(extra)), " +
+			"memo = COALESCE(memo, VALUES(memo)), " +
+			"inscription_hash = COALESCE(inscription_hash, VALUES(inscription_hash))")
+
+	r, err := b.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return false, err
+	}
 
-	_, err := b.RunWith(tx).ExecContext(ctx)
-	return err
+	// affected rows is 1 for an insert, 2 for an update and 0 if nothing changed
+	n, err := r.RowsAffected()
+	return n == 1, err
 }
 
-func (s *store) Save(ctx context.Context, outputs []*core.Output) error {
+func (s *store) Save(ctx context.Context, outputs []*core.Output) (int, error) {
 	tx := generic.Must(s.db.Begin())
 	defer tx.Rollback()
 
+	var inserted int
 	for _, output := range outputs {
-		if err := saveOutput(ctx, tx, output); err != nil {
-			return err
+		ok, err := saveOutput(ctx, tx, output)
+		if err != nil {
+			return 0, err
+		}
+
+		if ok {
+			inserted++
 		}
 	}
 
-	return tx.Commit()
+	return inserted, tx.Commit()
 }
 
 func (s *store) List(ctx context.Context, userID string, offset uint64, limit int) ([]*core.Output, error) {
@@ -73,10 +147,54 @@ func (s *store) List(ctx context.Context, userID string, offset uint64, limit in
 	return outputs, nil
 }
 
+func (s *store) Query(ctx context.Context, query core.OutputQuery) ([]*core.Output, error) {
+	b := sq.Select(scanColumns...).
+		From("outputs").
+		Where("sequence > ?", query.Offset).
+		OrderBy("sequence").
+		Limit(uint64(query.Limit))
+
+	if query.UserID != "" {
+		b = b.Where("user_id = ?", query.UserID)
+	}
+
+	if query.AssetID != "" {
+		b = b.Where("asset_id = ?", query.AssetID)
+	}
+
+	if query.State > 0 {
+		b = b.Where("state = ?", query.State)
+	}
+
+	if query.Deposit {
+		b = b.Where("NOT EXISTS (SELECT 1 FROM transfers WHERE transfers.tx_hash = outputs.hash)")
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var outputs []*core.Output
+	for rows.Next() {
+		var output core.Output
+		if err := scanOutput(rows, &output); err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, &output)
+	}
+
+	return outputs, rows.Err()
+}
+
 func (s *store) ListTarget(ctx context.Context, userID, assetID string, offset uint64, target decimal.Decimal, limit int) ([]*core.Output, error) {
 	b := sq.Select(scanColumns...).
 		From("outputs").
 		Where("user_id = ? AND asset_id = ? AND sequence > ?", userID, assetID, offset).
+		Where("state = ?", core.OutputStateUnspent).
 		OrderBy("sequence").
 		Limit(uint64(limit))
 	rows, err := b.RunWith(s.db).QueryContext(ctx)
@@ -125,10 +243,27 @@ func (s *store) ListRange(ctx context.Context, userID, assetID string, from, to
 			return nil, err
 		}
 
+		if output.State == core.OutputStateQuarantined {
+			return nil, fmt.Errorf("sequence %d: %w", output.Sequence, core.ErrOutputQuarantined)
+		}
+
 		outputs = append(outputs, &output)
 	}
 
-	return outputs, nil
+	return outputs, rows.Err()
+}
+
+func (s *store) Find(ctx context.Context, sequence uint64) (*core.Output, error) {
+	b := sq.Select(scanColumns...).
+		From("outputs").
+		Where("sequence = ?", sequence)
+
+	var output core.Output
+	if err := scanOutput(b.RunWith(s.db).QueryRowContext(ctx), &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
 }
 
 func (s *store) Delete(ctx context.Context, sequence uint64) error {
@@ -137,11 +272,58 @@ func (s *store) Delete(ctx context.Context, sequence uint64) error {
 	return err
 }
 
+func (s *store) Quarantine(ctx context.Context, sequences ...uint64) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+
+	b := sq.Update("outputs").
+		Set("state", core.OutputStateQuarantined).
+		Where(sq.Eq{"sequence": sequences})
+
+	_, err := b.RunWith(s.db).ExecContext(ctx)
+	return err
+}
+
+func (s *store) Unquarantine(ctx context.Context, sequence uint64, state core.OutputState) error {
+	b := sq.Update("outputs").
+		Set("state", state).
+		Where("sequence = ? AND state = ?", sequence, core.OutputStateQuarantined)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := r.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("output %d not quarantined", sequence)
+	}
+
+	return nil
+}
+
+func (s *store) DeleteSpent(ctx context.Context, before time.Time, limit int) (int64, error) {
+	b := sq.Delete("outputs").
+		Where("state = ? AND spent_at < ?", core.OutputStateSpent, before).
+		OrderBy("spent_at").
+		Limit(uint64(limit))
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
+
 func (s *store) SumBalances(ctx context.Context, userID, assetID string) ([]*core.Balance, error) {
 	b := sq.Select("outputs.user_id", "outputs.asset_id", "SUM(outputs.amount)", "COUNT(*)").
 		From("outputs").
 		LeftJoin("assigns ON outputs.asset_id = assigns.asset_id AND outputs.user_id = assigns.user_id").
 		Where("outputs.sequence > COALESCE(assigns.offset,0)").
+		Where("outputs.state = ?", core.OutputStateUnspent).
 		GroupBy("outputs.user_id", "outputs.asset_id")
 
 	if userID != "" {
@@ -0,0 +1,111 @@
+package output
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/storetest"
+	"github.com/shopspring/decimal"
+)
+
+func newOutput(userID string, sequence uint64) *core.Output {
+	return storetest.NewOutput(userID, uuid.NewString(), sequence, decimal.NewFromInt(1))
+}
+
+func TestSaveResequence(t *testing.T) {
+	ctx := context.Background()
+	outputs := New(storetest.Open(t))
+	userID := uuid.NewString()
+
+	// far above the outputs of the other tests
+	base := uint64(time.Now().UnixNano())
+	latest := newOutput(userID, base)
+	if n, err := outputs.Save(ctx, []*core.Output{latest}); err != nil {
+		t.Fatal(err)
+	} else if n != 1 || latest.Sequence != base {
+		t.Fatalf("expected the output inserted with sequence %d, got %d inserted with %d", base, n, latest.Sequence)
+	}
+
+	// a backfilled output from an older sequence goes after the stored ones
+	backfilled := newOutput(userID, base-10)
+	if _, err := outputs.Save(ctx, []*core.Output{backfilled}); err != nil {
+		t.Fatal(err)
+	}
+
+	if backfilled.Sequence <= base {
+		t.Fatalf("expected the backfilled output after %d, got %d", base, backfilled.Sequence)
+	}
+
+	// saved again, it keeps its stored sequence
+	again := *backfilled
+	again.Sequence = base - 10
+	again.State = core.OutputStateSpent
+	if n, err := outputs.Save(ctx, []*core.Output{&again}); err != nil {
+		t.Fatal(err)
+	} else if n != 0 || again.Sequence != backfilled.Sequence {
+		t.Fatalf("expected the stored sequence %d, got %d inserted with %d", backfilled.Sequence, n, again.Sequence)
+	}
+
+	// a released deposit goes after the stored ones too
+	ignored := newOutput(userID, base-20)
+	if err := outputs.Ignore(ctx, []*core.IgnoredOutput{{Output: *ignored, Reason: "unknown asset"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := outputs.Release(ctx, ignored.Sequence); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := outputs.List(ctx, userID, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stored) != 3 {
+		t.Fatalf("expected 3 outputs, got %d", len(stored))
+	}
+
+	if released := stored[2]; released.Hash != ignored.Hash || released.Sequence <= backfilled.Sequence {
+		t.Fatalf("expected the released output last, got %s at %d", released.Hash, released.Sequence)
+	}
+
+	// the released deposit is refundable by its stored sequence
+	deposit, err := outputs.FindDeposit(ctx, stored[2].Sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deposit.Hash != ignored.Hash || !deposit.Amount.Equal(ignored.Amount) {
+		t.Fatalf("expected the released deposit, got %s %s", deposit.Hash, deposit.Amount)
+	}
+}
+
+func TestDeposits(t *testing.T) {
+	ctx := context.Background()
+	outputs := New(storetest.Open(t))
+
+	deposit := newOutput(uuid.NewString(), uint64(time.Now().UnixNano()))
+	deposit.Senders = []string{uuid.NewString(), uuid.NewString()}
+	deposit.SendersThreshold = 2
+	deposit.Memo = "invoice"
+
+	// saved twice by a retried sync round
+	for i := 0; i < 2; i++ {
+		if err := outputs.SaveDeposits(ctx, []*core.Output{deposit}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the deposit outlives its output
+	found, err := outputs.FindDeposit(ctx, deposit.Sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found.UserID != deposit.UserID || found.SendersThreshold != 2 || len(found.Senders) != 2 || found.Memo != deposit.Memo {
+		t.Fatalf("unexpected deposit %+v", found)
+	}
+}
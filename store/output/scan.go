@@ -1,7 +1,10 @@
 package output
 
 import (
+	"database/sql"
+
 	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+	"github.com/lib/pq"
 	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
 )
@@ -18,12 +21,63 @@ var scanColumns = []string{
 	"user_id",
 	"asset_id",
 	"amount",
+	"state",
+	"spent_by",
+	"spent_at",
+	"senders",
+	"senders_threshold",
+	"extra",
+	"memo",
+	"inscription_hash",
+}
+
+// metadataValues are the values of the transaction columns, senders to inscription_hash
+func metadataValues(output *core.Output) []interface{} {
+	var senders interface{}
+	if len(output.Senders) > 0 {
+		senders = pq.StringArray(output.Senders)
+	}
+
+	return []interface{}{
+		senders,
+		output.SendersThreshold,
+		nullString(output.Extra),
+		nullString(output.Memo),
+		nullString(output.InscriptionHash),
+	}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+type metadata struct {
+	senders         pq.StringArray
+	extra           sql.NullString
+	memo            sql.NullString
+	inscriptionHash sql.NullString
+}
+
+func (m *metadata) dest(output *core.Output) []interface{} {
+	return []interface{}{&m.senders, &output.SendersThreshold, &m.extra, &m.memo, &m.inscriptionHash}
+}
+
+func (m *metadata) apply(output *core.Output) {
+	output.Senders = m.senders
+	output.Extra = m.extra.String
+	output.Memo = m.memo.String
+	output.InscriptionHash = m.inscriptionHash.String
 }
 
 func scanOutput(scanner scanner, output *core.Output) error {
-	var hash string
+	var (
+		hash    string
+		spentBy sql.NullString
+		spentAt sql.NullTime
+		meta    metadata
+	)
 
-	if err := scanner.Scan(
+	dest := []interface{}{
 		&output.Sequence,
 		&output.CreatedAt,
 		&hash,
@@ -31,10 +85,19 @@ func scanOutput(scanner scanner, output *core.Output) error {
 		&output.UserID,
 		&output.AssetID,
 		&output.Amount,
-	); err != nil {
+		&output.State,
+		&spentBy,
+		&spentAt,
+	}
+
+	if err := scanner.Scan(append(dest, meta.dest(output)...)...); err != nil {
 		return err
 	}
 
+	output.SpentBy = spentBy.String
+	output.SpentAt = spentAt.Time
+	meta.apply(output)
+
 	output.Hash = generic.Must(mixinnet.HashFromString(hash))
 	return nil
 }
@@ -7,19 +7,22 @@ import (
 	"errors"
 	"fmt"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
 	"github.com/tsenart/nap"
 )
 
-type store struct {
+type propertyStore struct {
 	db *nap.DB
 }
 
 func New(db *nap.DB) core.PropertyStore {
-	return &store{db: db}
+	return &propertyStore{db: db}
 }
 
-func (s *store) Get(ctx context.Context, key string, value any) error {
+func (s *propertyStore) Get(ctx context.Context, key string, value any) error {
 	var raw []byte
 	if err := s.db.QueryRowContext(ctx, "SELECT `value` FROM properties WHERE `key` = ?", key).Scan(&raw); err == nil {
 		return json.Unmarshal(raw, value)
@@ -30,27 +33,133 @@ func (s *store) Get(ctx context.Context, key string, value any) error {
 	}
 }
 
-func (s *store) Set(ctx context.Context, key string, value any) error {
+func (s *propertyStore) Set(ctx context.Context, key string, value any) error {
 	jsonValue, err := json.Marshal(value)
 	if err != nil {
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
 
-	r, err := s.db.ExecContext(ctx, "UPDATE `properties` SET `value` = ?, `version` = `version` + 1 WHERE `key` = ?", jsonValue, key)
-	if err != nil {
+	if _, err := s.db.ExecContext(ctx,
+		"INSERT INTO `properties` (`key`, `value`, `version`) VALUES (?, ?, 1) ON DUPLICATE KEY UPDATE `value` = VALUES(`value`), `version` = `version` + 1",
+		key, jsonValue,
+	); err != nil {
 		return fmt.Errorf("failed to set property: %w", err)
 	}
 
+	return nil
+}
+
+// GetVersioned reads from the master, the version is compared with the next write
+func (s *propertyStore) GetVersioned(ctx context.Context, key string, value any) (uint64, error) {
+	var (
+		raw     []byte
+		version uint64
+	)
+
+	if err := s.db.Master().QueryRowContext(ctx, "SELECT `value`, `version` FROM properties WHERE `key` = ?", key).Scan(&raw, &version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return version, json.Unmarshal(raw, value)
+}
+
+// CompareAndSet runs in a transaction with the lease of the context locked, a
+// stale leader can't move the property once the lease changed hands
+func (s *propertyStore) CompareAndSet(ctx context.Context, key string, version uint64, value any) (uint64, error) {
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	if version == 0 {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO `properties` (`key`, `value`, `version`) VALUES (?, ?, 1)", key, jsonValue); err != nil {
+			if store.IsErrDuplicate(err) {
+				return 0, core.ErrVersionConflict
+			}
+
+			return 0, fmt.Errorf("failed to set property: %w", err)
+		}
+
+		return 1, tx.Commit()
+	}
+
+	r, err := tx.ExecContext(ctx,
+		"UPDATE `properties` SET `value` = ?, `version` = `version` + 1 WHERE `key` = ? AND `version` = ?",
+		jsonValue, key, version,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set property: %w", err)
+	}
+
 	n, err := r.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	if n > 0 {
-		return nil
+	if n == 0 {
+		return 0, core.ErrVersionConflict
 	}
 
-	_, err = s.db.ExecContext(ctx, "INSERT INTO `properties` (`key`, `value`) VALUES (?, ?)", key, jsonValue)
+	return version + 1, tx.Commit()
+}
+
+func (s *propertyStore) Delete(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM `properties` WHERE `key` = ?", key)
 	return err
+}
+
+func (s *propertyStore) List(ctx context.Context, prefix string) ([]*core.Property, error) {
+	b := sq.Select("`key`", "`value`", "`version`", "updated_at").
+		From("properties").
+		Where(sq.Like{"`key`": escapeLike(prefix) + "%"}).
+		OrderBy("`key`")
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var properties []*core.Property
+	for rows.Next() {
+		var (
+			p         core.Property
+			updatedAt sql.NullTime
+		)
+
+		if err := rows.Scan(&p.Key, &p.Value, &p.Version, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		p.UpdatedAt = updatedAt.Time
+		properties = append(properties, &p)
+	}
+
+	return properties, rows.Err()
+}
+
+func escapeLike(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '%', '_', '\\':
+			b = append(b, '\\')
+		}
+
+		b = append(b, s[i])
+	}
 
+	return string(b)
 }
@@ -0,0 +1,89 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/storetest"
+)
+
+const racers = 8
+
+func countWins(t *testing.T, errs []error) int {
+	t.Helper()
+
+	var wins int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			wins++
+		case !errors.Is(err, core.ErrVersionConflict):
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	return wins
+}
+
+func TestCompareAndSetRace(t *testing.T) {
+	s := New(storetest.Open(t))
+	ctx := context.Background()
+	key := "test:" + uuid.NewString()
+
+	// the first write races on the insert
+	errs := storetest.Race(racers, func(i int) error {
+		_, err := s.CompareAndSet(ctx, key, 0, i)
+		return err
+	})
+
+	if wins := countWins(t, errs); wins != 1 {
+		t.Fatalf("%d first writes won", wins)
+	}
+
+	errs = storetest.Race(racers, func(i int) error {
+		_, err := s.CompareAndSet(ctx, key, 1, i)
+		return err
+	})
+
+	if wins := countWins(t, errs); wins != 1 {
+		t.Fatalf("%d updates of the same version won", wins)
+	}
+
+	var value int
+	version, err := s.GetVersioned(ctx, key, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version != 2 {
+		t.Fatalf("version %d after two writes", version)
+	}
+}
+
+func TestSetRace(t *testing.T) {
+	s := New(storetest.Open(t))
+	ctx := context.Background()
+	key := "test:" + uuid.NewString()
+
+	// concurrent first writes are upserted, none fails on the duplicate key
+	for _, err := range storetest.Race(racers, func(i int) error {
+		return s.Set(ctx, key, i)
+	}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var value int
+	version, err := s.GetVersioned(ctx, key, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version != racers {
+		t.Fatalf("version %d after %d writes", version, racers)
+	}
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/tsenart/nap"
+)
+
+// New returns a limiter keeping the buckets in the database, so they are shared
+// by all server replicas. Each take locks the bucket row in a transaction, put
+// a local limiter in front of it to shed floods, see ratelimit.NewShared.
+func New(db *nap.DB) core.RateBucketStore {
+	return &limiter{db: db}
+}
+
+type limiter struct {
+	db *nap.DB
+}
+
+func (l *limiter) Take(ctx context.Context, key string, limit core.RateLimit) (time.Duration, bool, error) {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+
+	defer tx.Rollback()
+
+	var (
+		now     = time.Now().UTC()
+		tokens  = float64(limit.Burst)
+		elapsed time.Duration
+		last    time.Time
+	)
+
+	b := sq.Select("tokens", "updated_at").
+		From("rate_buckets").
+		Where("`key` = ?", key).
+		Suffix("FOR UPDATE")
+
+	if err := b.RunWith(tx).QueryRowContext(ctx).Scan(&tokens, &last); err == nil {
+		elapsed = max(now.Sub(last), 0)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return 0, false, err
+	}
+
+	tokens, wait, ok := limit.Take(tokens, elapsed)
+
+	u := sq.Insert("rate_buckets").
+		Columns("`key`", "tokens", "updated_at").
+		Values(key, tokens, now).
+		Suffix("ON DUPLICATE KEY UPDATE `tokens` = VALUES(`tokens`), `updated_at` = VALUES(`updated_at`)")
+	if _, err := u.RunWith(tx).ExecContext(ctx); err != nil {
+		return 0, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, false, err
+	}
+
+	return wait, ok, nil
+}
+
+func (l *limiter) DeleteIdle(ctx context.Context, before time.Time, limit int) (int64, error) {
+	b := sq.Delete("rate_buckets").
+		Where("updated_at < ?", before.UTC()).
+		OrderBy("updated_at").
+		Limit(uint64(limit))
+
+	r, err := b.RunWith(l.db).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.RowsAffected()
+}
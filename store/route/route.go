@@ -0,0 +1,251 @@
+package route
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.RouteStore {
+	return &routeStore{db: db}
+}
+
+type routeStore struct {
+	db *nap.DB
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+var ruleColumns = []string{"id", "created_at", "user_id", "priority", "memo_prefix", "memo_regex", "sender", "asset_id", "min_amount", "max_amount", "account"}
+
+func scanRule(scanner scanner, rule *core.RouteRule) error {
+	return scanner.Scan(
+		&rule.ID,
+		&rule.CreatedAt,
+		&rule.UserID,
+		&rule.Priority,
+		&rule.MemoPrefix,
+		&rule.MemoRegex,
+		&rule.Sender,
+		&rule.AssetID,
+		&rule.MinAmount,
+		&rule.MaxAmount,
+		&rule.Account,
+	)
+}
+
+var routeColumns = []string{"output_sequence", "created_at", "user_id", "asset_id", "amount", "memo", "account", "rule_id", "operator"}
+
+func scanRoute(scanner scanner, route *core.DepositRoute) error {
+	var memo sql.NullString
+
+	if err := scanner.Scan(
+		&route.OutputSequence,
+		&route.CreatedAt,
+		&route.UserID,
+		&route.AssetID,
+		&route.Amount,
+		&memo,
+		&route.Account,
+		&route.RuleID,
+		&route.Operator,
+	); err != nil {
+		return err
+	}
+
+	route.Memo = memo.String
+	return nil
+}
+
+func (s *routeStore) SaveRule(ctx context.Context, rule *core.RouteRule) error {
+	if rule.ID > 0 {
+		b := sq.Update("route_rules").
+			Set("priority", rule.Priority).
+			Set("memo_prefix", rule.MemoPrefix).
+			Set("memo_regex", rule.MemoRegex).
+			Set("sender", rule.Sender).
+			Set("asset_id", rule.AssetID).
+			Set("min_amount", rule.MinAmount).
+			Set("max_amount", rule.MaxAmount).
+			Set("account", rule.Account).
+			Where("id = ? AND user_id = ?", rule.ID, rule.UserID)
+
+		_, err := b.RunWith(s.db).ExecContext(ctx)
+		return err
+	}
+
+	b := sq.Insert("route_rules").
+		Columns("user_id", "priority", "memo_prefix", "memo_regex", "sender", "asset_id", "min_amount", "max_amount", "account").
+		Values(rule.UserID, rule.Priority, rule.MemoPrefix, rule.MemoRegex, rule.Sender, rule.AssetID, rule.MinAmount, rule.MaxAmount, rule.Account)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := r.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	rule.ID = uint64(id)
+	return nil
+}
+
+func (s *routeStore) FindRule(ctx context.Context, id uint64) (*core.RouteRule, error) {
+	b := sq.Select(ruleColumns...).
+		From("route_rules").
+		Where("id = ?", id)
+
+	var rule core.RouteRule
+	if err := scanRule(b.RunWith(s.db.Master()).QueryRowContext(ctx), &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (s *routeStore) DeleteRule(ctx context.Context, id uint64) error {
+	b := sq.Delete("route_rules").Where("id = ?", id)
+	_, err := b.RunWith(s.db).ExecContext(ctx)
+	return err
+}
+
+func (s *routeStore) ListRules(ctx context.Context, userID string) ([]*core.RouteRule, error) {
+	b := sq.Select(ruleColumns...).
+		From("route_rules").
+		OrderBy("priority", "id")
+
+	if userID != "" {
+		b = b.Where("user_id = ?", userID)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var rules []*core.RouteRule
+	for rows.Next() {
+		var rule core.RouteRule
+		if err := scanRule(rows, &rule); err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (s *routeStore) Route(ctx context.Context, routes []*core.DepositRoute) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	b := sq.Insert("deposit_routes").
+		Options("IGNORE").
+		Columns("output_sequence", "user_id", "asset_id", "amount", "memo", "account", "rule_id")
+
+	for _, route := range routes {
+		b = b.Values(route.OutputSequence, route.UserID, route.AssetID, route.Amount, route.Memo, route.Account, route.RuleID)
+	}
+
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return err
+	}
+
+	if _, err := b.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *routeStore) FindRoute(ctx context.Context, sequence uint64) (*core.DepositRoute, error) {
+	b := sq.Select(routeColumns...).
+		From("deposit_routes").
+		Where("output_sequence = ?", sequence)
+
+	var route core.DepositRoute
+	if err := scanRoute(b.RunWith(s.db.Master()).QueryRowContext(ctx), &route); err != nil {
+		return nil, err
+	}
+
+	return &route, nil
+}
+
+func (s *routeStore) ListRoutes(ctx context.Context, query core.DepositRouteQuery) ([]*core.DepositRoute, error) {
+	b := sq.Select(routeColumns...).
+		From("deposit_routes").
+		Where("output_sequence > ?", query.Offset).
+		OrderBy("output_sequence").
+		Limit(uint64(query.Limit))
+
+	if query.UserID != "" {
+		b = b.Where("user_id = ?", query.UserID)
+	}
+
+	if query.Unmatched {
+		b = b.Where("account = ''")
+	} else if query.Account != "" {
+		b = b.Where("account = ?", query.Account)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var routes []*core.DepositRoute
+	for rows.Next() {
+		var route core.DepositRoute
+		if err := scanRoute(rows, &route); err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, &route)
+	}
+
+	return routes, rows.Err()
+}
+
+func (s *routeStore) Assign(ctx context.Context, route *core.DepositRoute, account, operator string) error {
+	b := sq.Update("deposit_routes").
+		Set("account", account).
+		Set("operator", operator).
+		Where("output_sequence = ? AND account = ''", route.OutputSequence)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("optimistic lock failed")
+	}
+
+	route.Account = account
+	route.Operator = operator
+	return nil
+}
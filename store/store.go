@@ -3,8 +3,16 @@ package store
 import (
 	"database/sql"
 	"errors"
+
+	"github.com/go-sql-driver/mysql"
 )
 
 func IsErrNotFound(err error) bool {
 	return errors.Is(err, sql.ErrNoRows)
 }
+
+// IsErrDuplicate reports whether err violates a unique key
+func IsErrDuplicate(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == 1062
+}
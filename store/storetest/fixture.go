@@ -0,0 +1,48 @@
+package storetest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+// Race runs fn n times concurrently and returns the errors, by index
+func Race(n int, fn func(i int) error) []error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, n)
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+// NewOutput returns an unspent output with a random hash, the sequence must
+// be unique across the tests, e.g. based on time.Now().UnixNano()
+func NewOutput(userID, assetID string, sequence uint64, amount decimal.Decimal) *core.Output {
+	var hash mixinnet.Hash
+	copy(hash[:], uuid.New().NodeID()[:])
+	copy(hash[8:], uuid.New().String())
+
+	return &core.Output{
+		Sequence:  sequence,
+		CreatedAt: time.Now().Truncate(time.Second),
+		Hash:      hash,
+		UserID:    userID,
+		AssetID:   assetID,
+		Amount:    amount,
+		State:     core.OutputStateUnspent,
+	}
+}
@@ -0,0 +1,40 @@
+// Package storetest opens the database of the store tests. They run against a
+// disposable mysql database set by SAFEWALLET_TEST_DSN, e.g.
+// root:root@tcp(127.0.0.1:3306)/safewallet_test?parseTime=true&multiStatements=true,
+// and are skipped without it.
+package storetest
+
+import (
+	"os"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/pandodao/safe-wallet/store/db"
+	"github.com/tsenart/nap"
+)
+
+const EnvDSN = "SAFEWALLET_TEST_DSN"
+
+// Open connects & migrates the test database, the tests must not depend on
+// the rows written by others
+func Open(t *testing.T) *nap.DB {
+	t.Helper()
+
+	dsn := os.Getenv(EnvDSN)
+	if dsn == "" {
+		t.Skipf("%s not set", EnvDSN)
+	}
+
+	conn, err := nap.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	if err := db.Migrate(conn.Master(), db.MigrateData{UserID: "00000000-0000-0000-0000-000000000000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	return conn
+}
@@ -0,0 +1,108 @@
+package topup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/tsenart/nap"
+)
+
+func New(db *nap.DB) core.TopupStore {
+	return &store{db: db}
+}
+
+type store struct {
+	db *nap.DB
+}
+
+func (s *store) Save(ctx context.Context, rule *core.TopupRule) error {
+	b := sq.Insert("topup_rules").
+		Columns("user_id", "asset_id", "floor", "target").
+		Values(rule.UserID, rule.AssetID, rule.Floor, rule.Target).
+		Suffix("ON DUPLICATE KEY UPDATE `floor` = VALUES(`floor`), `target` = VALUES(`target`)")
+	_, err := b.RunWith(s.db).ExecContext(ctx)
+	return err
+}
+
+func (s *store) Delete(ctx context.Context, userID, assetID string) error {
+	b := sq.Delete("topup_rules").Where("user_id = ? AND asset_id = ?", userID, assetID)
+	_, err := b.RunWith(s.db).ExecContext(ctx)
+	return err
+}
+
+func (s *store) List(ctx context.Context, userID string) ([]*core.TopupRule, error) {
+	b := sq.Select(columns...).
+		From("topup_rules").
+		OrderBy("id")
+
+	if userID != "" {
+		b = b.Where("user_id = ?", userID)
+	}
+
+	return s.list(ctx, b)
+}
+
+// ListDue compares refilled_at with the database clock, which sets it
+func (s *store) ListDue(ctx context.Context, cooldown time.Duration) ([]*core.TopupRule, error) {
+	b := sq.Select(columns...).
+		From("topup_rules").
+		Where("refilled_at IS NULL OR refilled_at < DATE_SUB(NOW(), INTERVAL ? SECOND)", int64(cooldown.Seconds())).
+		OrderBy("id")
+
+	return s.list(ctx, b)
+}
+
+var columns = []string{"id", "created_at", "user_id", "asset_id", "floor", "target", "refills", "refilled_at"}
+
+func (s *store) list(ctx context.Context, b sq.SelectBuilder) ([]*core.TopupRule, error) {
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var rules []*core.TopupRule
+	for rows.Next() {
+		var (
+			rule       core.TopupRule
+			refilledAt sql.NullTime
+		)
+
+		if err := rows.Scan(&rule.ID, &rule.CreatedAt, &rule.UserID, &rule.AssetID, &rule.Floor, &rule.Target, &rule.Refills, &refilledAt); err != nil {
+			return nil, err
+		}
+
+		rule.RefilledAt = refilledAt.Time
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (s *store) Refilled(ctx context.Context, rule *core.TopupRule) error {
+	b := sq.Update("topup_rules").
+		Set("refills", rule.Refills+1).
+		Set("refilled_at", sq.Expr("NOW()")).
+		Where("id = ? AND refills = ?", rule.ID, rule.Refills)
+
+	r, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("optimistic lock failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/ledger"
+	"github.com/pandodao/safe-wallet/store/output"
+	"github.com/pandodao/safe-wallet/store/storetest"
+	"github.com/shopspring/decimal"
+)
+
+func TestAssignBacking(t *testing.T) {
+	ctx := context.Background()
+	db := storetest.Open(t)
+	transfers, outputs, entries := New(db), output.New(db), ledger.New(db)
+
+	userID, assetID := uuid.NewString(), uuid.NewString()
+
+	// two outputs of 5, 8 of the 10 are credited to a virtual account
+	var saved []*core.Output
+	for i := 0; i < 2; i++ {
+		saved = append(saved, storetest.NewOutput(userID, assetID, uint64(time.Now().UnixNano()), decimal.NewFromInt(5)))
+	}
+
+	if _, err := outputs.Save(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := entries.Post(ctx, core.NewDepositPosting(uuid.NewString(), userID, assetID, "alice", decimal.NewFromInt(8), "")); err != nil {
+		t.Fatal(err)
+	}
+
+	newTransfer := func(amount int64, opponent string, to uint64) *core.Transfer {
+		return &core.Transfer{
+			TraceID:     uuid.NewString(),
+			Status:      core.TransferStatusPending,
+			UserID:      userID,
+			AssetID:     assetID,
+			Amount:      decimal.NewFromInt(amount),
+			Opponent:    mixin.RequireNewMixAddress([]string{opponent}, 1),
+			AssignRange: [2]uint64{saved[0].Sequence, to},
+		}
+	}
+
+	// spending the allocated funds is refused
+	if err := transfers.Assign(ctx, newTransfer(3, uuid.NewString(), saved[0].Sequence), 0); !errors.Is(err, core.ErrLedgerExceedsBalance) {
+		t.Fatalf("expected ErrLedgerExceedsBalance, got %v", err)
+	}
+
+	// a merge keeps the funds in the wallet, the merged amount still backs the accounts
+	merge := newTransfer(10, userID, saved[1].Sequence)
+	merge.Internal = true
+	if err := transfers.Assign(ctx, merge, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := entries.Post(ctx, core.NewDepositPosting(uuid.NewString(), userID, assetID, "alice", decimal.NewFromInt(2), "")); err != nil {
+		t.Fatal(fmt.Errorf("credit while merging: %w", err))
+	}
+
+	if _, err := entries.Post(ctx, core.NewDepositPosting(uuid.NewString(), userID, assetID, "alice", decimal.NewFromInt(1), "")); !errors.Is(err, core.ErrLedgerExceedsBalance) {
+		t.Fatalf("expected ErrLedgerExceedsBalance, got %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/storetest"
+	"github.com/shopspring/decimal"
+)
+
+func TestHold(t *testing.T) {
+	ctx := context.Background()
+	transfers := New(storetest.Open(t))
+
+	userID := uuid.NewString()
+	base := uint64(time.Now().UnixNano())
+
+	hold := func(expires time.Time) *core.Transfer {
+		transfer := &core.Transfer{
+			TraceID:     uuid.NewString(),
+			Status:      core.TransferStatusHeld,
+			UserID:      userID,
+			AssetID:     uuid.NewString(),
+			Amount:      decimal.NewFromInt(10),
+			Opponent:    mixin.RequireNewMixAddress([]string{uuid.NewString()}, 1),
+			AssignRange: [2]uint64{base, base + 1},
+			HoldExpires: expires,
+		}
+
+		if err := transfers.Assign(ctx, transfer, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := transfers.FindTrace(ctx, transfer.TraceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found.Status != core.TransferStatusHeld {
+			t.Fatalf("expected the transfer held, got %s", found.Status)
+		}
+
+		return found
+	}
+
+	t.Run("capture", func(t *testing.T) {
+		transfer := hold(time.Now().Add(time.Hour))
+		if err := transfers.Capture(ctx, transfer, decimal.NewFromInt(4)); err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := transfers.FindTrace(ctx, transfer.TraceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found.Status != core.TransferStatusAssigned || !found.Amount.Equal(decimal.NewFromInt(4)) {
+			t.Fatalf("expected the transfer assigned for 4, got %s for %s", found.Status, found.Amount)
+		}
+
+		// a captured hold can't be captured again or released
+		if err := transfers.Capture(ctx, found, decimal.NewFromInt(4)); !errors.Is(err, core.ErrTransferNotHeld) {
+			t.Fatalf("expected ErrTransferNotHeld, got %v", err)
+		}
+
+		if _, err := transfers.Release(ctx, found); !errors.Is(err, core.ErrTransferNotHeld) {
+			t.Fatalf("expected ErrTransferNotHeld, got %v", err)
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		transfer := hold(time.Now().Add(time.Hour))
+		release, err := transfers.Release(ctx, transfer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := transfers.FindTrace(ctx, release.TraceID); err != nil {
+			t.Fatal(err)
+		}
+
+		// a released hold can't be captured or released again
+		held := *transfer
+		held.Status = core.TransferStatusHeld
+		if err := transfers.Capture(ctx, &held, decimal.NewFromInt(10)); !errors.Is(err, core.ErrTransferNotHeld) {
+			t.Fatalf("expected ErrTransferNotHeld, got %v", err)
+		}
+
+		if _, err := transfers.Release(ctx, &held); !errors.Is(err, core.ErrTransferNotHeld) {
+			t.Fatalf("expected ErrTransferNotHeld, got %v", err)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		transfer := hold(time.Now().Add(-time.Minute))
+
+		// expired holds can't be captured, only released
+		if err := transfers.Capture(ctx, transfer, decimal.NewFromInt(10)); !errors.Is(err, core.ErrTransferNotHeld) {
+			t.Fatalf("expected ErrTransferNotHeld, got %v", err)
+		}
+
+		expired, err := transfers.ListExpiredHolds(ctx, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var listed bool
+		for _, e := range expired {
+			listed = listed || e.TraceID == transfer.TraceID
+		}
+
+		if !listed {
+			t.Fatal("expected the expired hold listed")
+		}
+
+		if _, err := transfers.Release(ctx, transfer); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
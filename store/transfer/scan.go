@@ -29,8 +29,13 @@ var scanColumns = []string{
 	"memo",
 	"opponents",
 	"threshold",
+	"internal",
 	"output_from",
 	"output_to",
+	"tx_hash",
+	"output_sequence",
+	"refund_of",
+	"hold_expires",
 }
 
 func scanTransfer(scanner scanner, transfer *core.Transfer) error {
@@ -38,6 +43,9 @@ func scanTransfer(scanner scanner, transfer *core.Transfer) error {
 		opponents pq.StringArray
 		threshold uint8
 		memo      sql.NullString
+		txHash    sql.NullString
+		sequence  sql.NullInt64
+		expires   sql.NullTime
 	)
 
 	if err := scanner.Scan(
@@ -51,13 +59,21 @@ func scanTransfer(scanner scanner, transfer *core.Transfer) error {
 		&memo,
 		&opponents,
 		&threshold,
+		&transfer.Internal,
 		&transfer.AssignRange[0],
 		&transfer.AssignRange[1],
+		&txHash,
+		&sequence,
+		&transfer.RefundOf,
+		&expires,
 	); err != nil {
 		return err
 	}
 
 	transfer.Memo = memo.String
+	transfer.TxHash = txHash.String
+	transfer.OutputSequence = uint64(sequence.Int64)
+	transfer.HoldExpires = expires.Time
 	transfer.Opponent = mixin.RequireNewMixAddress(opponents, threshold)
 	return nil
 }
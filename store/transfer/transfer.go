@@ -5,19 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
 	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/pandodao/safe-wallet/store/ledger"
+	"github.com/shopspring/decimal"
 	"github.com/tsenart/nap"
 )
 
 func New(db *nap.DB) core.TransferStore {
-	return &store{db: db}
+	return &transferStore{db: db}
 }
 
-type store struct {
+type transferStore struct {
 	db *nap.DB
 }
 
@@ -25,8 +29,8 @@ func insert(ctx context.Context, r sq.BaseRunner, transfer *core.Transfer) error
 	opponents := pq.StringArray(transfer.Opponent.Members())
 	threshold := transfer.Opponent.Threshold
 	b := sq.Insert("transfers").
-		Columns("trace_id", "status", "user_id", "asset_id", "amount", "memo", "opponents", "threshold", "output_from", "output_to").
-		Values(transfer.TraceID, transfer.Status, transfer.UserID, transfer.AssetID, transfer.Amount, transfer.Memo, opponents, threshold, transfer.AssignRange[0], transfer.AssignRange[1])
+		Columns("trace_id", "status", "user_id", "asset_id", "amount", "memo", "opponents", "threshold", "internal", "output_from", "output_to", "refund_of", "hold_expires").
+		Values(transfer.TraceID, transfer.Status, transfer.UserID, transfer.AssetID, transfer.Amount, transfer.Memo, opponents, threshold, transfer.Internal, transfer.AssignRange[0], transfer.AssignRange[1], transfer.RefundOf, nullTime(transfer.HoldExpires))
 
 	_, err := b.RunWith(r).ExecContext(ctx)
 	return err
@@ -38,6 +42,11 @@ func update(ctx context.Context, r sq.BaseRunner, transfer *core.Transfer, to co
 		Set("output_from", transfer.AssignRange[0]).
 		Set("output_to", transfer.AssignRange[1]).
 		Where("id = ? AND status = ?", transfer.ID, transfer.Status)
+
+	if transfer.TxHash != "" {
+		b = b.Set("tx_hash", transfer.TxHash)
+	}
+
 	result, err := b.RunWith(r).ExecContext(ctx)
 	if err != nil {
 		return err
@@ -55,7 +64,7 @@ func update(ctx context.Context, r sq.BaseRunner, transfer *core.Transfer, to co
 	return err
 }
 
-func (s *store) Create(ctx context.Context, transfer *core.Transfer) error {
+func (s *transferStore) Create(ctx context.Context, transfer *core.Transfer) error {
 	return insert(ctx, s.db, transfer)
 }
 
@@ -70,17 +79,29 @@ func assign(ctx context.Context, tx *sql.Tx, transfer *core.Transfer, previousOf
 	}
 
 	if transfer.ID == 0 {
-		transfer.Status = core.TransferStatusAssigned
+		// held transfers keep their status until captured
+		if transfer.Status != core.TransferStatusHeld {
+			transfer.Status = core.TransferStatusAssigned
+		}
+
 		return insert(ctx, tx, transfer)
 	}
 
 	return update(ctx, tx, transfer, core.TransferStatusAssigned)
 }
 
-func (s *store) Assign(ctx context.Context, transfer *core.Transfer, previousOffset uint64) error {
+func (s *transferStore) Assign(ctx context.Context, transfer *core.Transfer, previousOffset uint64) error {
 	tx := generic.Must(s.db.Begin())
 	defer tx.Rollback()
 
+	if err := store.Fence(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := checkBacking(ctx, tx, transfer, transfer.Amount); err != nil {
+		return err
+	}
+
 	if err := assign(ctx, tx, transfer, previousOffset); err != nil {
 		return err
 	}
@@ -88,11 +109,37 @@ func (s *store) Assign(ctx context.Context, transfer *core.Transfer, previousOff
 	return tx.Commit()
 }
 
-func (s *store) UpdateStatus(ctx context.Context, transfer *core.Transfer, to core.TransferStatus) error {
+// checkBacking keeps the virtual balances of the wallet backed after the
+// transfer spends amount out of the unassigned outputs, transfers to the
+// wallet itself don't change its balance
+func checkBacking(ctx context.Context, tx *sql.Tx, transfer *core.Transfer, amount decimal.Decimal) error {
+	chain, err := ledger.LockChain(ctx, tx, transfer.UserID, transfer.AssetID)
+	if err != nil {
+		return err
+	}
+
+	virtual := chain.Neg()
+	if !virtual.IsPositive() || transfer.ToSelf() {
+		return nil
+	}
+
+	backing, err := ledger.SumBacking(ctx, tx, transfer.UserID, transfer.AssetID)
+	if err != nil {
+		return err
+	}
+
+	if backing.Sub(amount).LessThan(virtual) {
+		return core.ErrLedgerExceedsBalance
+	}
+
+	return nil
+}
+
+func (s *transferStore) UpdateStatus(ctx context.Context, transfer *core.Transfer, to core.TransferStatus) error {
 	return update(ctx, s.db, transfer, to)
 }
 
-func (s *store) FindTrace(ctx context.Context, traceID string) (*core.Transfer, error) {
+func (s *transferStore) FindTrace(ctx context.Context, traceID string) (*core.Transfer, error) {
 	b := sq.Select(scanColumns...).
 		From("transfers").
 		Where("trace_id = ?", traceID)
@@ -106,11 +153,21 @@ func (s *store) FindTrace(ctx context.Context, traceID string) (*core.Transfer,
 	return &transfer, nil
 }
 
-func (s *store) ListStatus(ctx context.Context, status core.TransferStatus, limit int) ([]*core.Transfer, error) {
-	b := sq.Select(scanColumns...).
+func (s *transferStore) ListStatus(ctx context.Context, status core.TransferStatus, limit int) ([]*core.Transfer, error) {
+	columns := make([]string, len(scanColumns))
+	for idx, column := range scanColumns {
+		columns[idx] = "transfers." + column
+	}
+
+	// transfers of frozen wallets are held until the wallet is unfrozen, archived
+	// wallets only send the sweeps of their late deposits
+	b := sq.Select(columns...).
 		From("transfers").
-		Where("status = ?", status).
-		OrderBy("id").
+		LeftJoin("wallets ON wallets.user_id = transfers.user_id").
+		Where("transfers.status = ?", status).
+		Where(sq.Or{sq.Eq{"wallets.status": nil}, sq.NotEq{"wallets.status": []core.WalletStatus{core.WalletStatusFrozen, core.WalletStatusArchived}}, sq.Eq{"wallets.status": core.WalletStatusArchived, "transfers.memo": core.ArchiveSweepMemo}}).
+		Where("transfers.claim_expires IS NULL OR transfers.claim_expires < NOW()").
+		OrderBy("transfers.id").
 		Limit(uint64(limit))
 
 	rows, err := b.RunWith(s.db).QueryContext(ctx)
@@ -133,7 +190,42 @@ func (s *store) ListStatus(ctx context.Context, status core.TransferStatus, limi
 	return transfers, nil
 }
 
-func (s *store) GetAssignOffset(ctx context.Context, userID, assetID string) (uint64, error) {
+// Claim uses the database clock, so the claims of replicas with skewed clocks still expire in order
+func (s *transferStore) Claim(ctx context.Context, transfer *core.Transfer, holder string, ttl time.Duration) (bool, error) {
+	b := sq.Update("transfers").
+		Set("claimed_by", holder).
+		Set("claim_expires", sq.Expr("DATE_ADD(NOW(), INTERVAL ? SECOND)", int64(ttl.Seconds()))).
+		Where("id = ? AND status = ?", transfer.ID, core.TransferStatusAssigned).
+		Where("claim_expires IS NULL OR claim_expires < NOW() OR claimed_by = ?", holder)
+
+	result, err := b.RunWith(s.db).ExecContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (s *transferStore) CountInflight(ctx context.Context, userID string) (int, error) {
+	b := sq.Select("COUNT(*)").
+		From("transfers").
+		Where("user_id = ?", userID).
+		Where(sq.Eq{"status": []core.TransferStatus{core.TransferStatusPending, core.TransferStatusAssigned, core.TransferStatusHeld}})
+
+	var count int
+	if err := b.RunWith(s.db.Master()).QueryRowContext(ctx).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *transferStore) GetAssignOffset(ctx context.Context, userID, assetID string) (uint64, error) {
 	b := sq.Select("offset").
 		From("assigns").
 		Where("user_id = ? AND asset_id = ?", userID, assetID)
@@ -146,3 +238,269 @@ func (s *store) GetAssignOffset(ctx context.Context, userID, assetID string) (ui
 
 	return offset, nil
 }
+
+func (s *transferStore) List(ctx context.Context, query core.TransferQuery) ([]*core.Transfer, error) {
+	b := sq.Select(scanColumns...).
+		From("transfers").
+		Where("id > ?", query.Offset).
+		OrderBy("id").
+		Limit(uint64(query.Limit))
+
+	if query.UserID != "" {
+		b = b.Where("user_id = ?", query.UserID)
+	}
+
+	if query.AssetID != "" {
+		b = b.Where("asset_id = ?", query.AssetID)
+	}
+
+	if query.Internal != nil {
+		b = b.Where("internal = ?", *query.Internal)
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var transfers []*core.Transfer
+	for rows.Next() {
+		var transfer core.Transfer
+		if err := scanTransfer(rows, &transfer); err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, &transfer)
+	}
+
+	return transfers, rows.Err()
+}
+
+func (s *transferStore) ListTxHashes(ctx context.Context, hashes []string) ([]string, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	b := sq.Select("tx_hash").
+		From("transfers").
+		Where(sq.Eq{"tx_hash": hashes})
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var found []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+
+		found = append(found, hash)
+	}
+
+	return found, rows.Err()
+}
+
+// LinkOutputs sets the output sequence of the internal transfers spent by the
+// outputs' transactions, the receiver's output is always the first one.
+func (s *transferStore) LinkOutputs(ctx context.Context, outputs []*core.Output) error {
+	byHash := make(map[string][]*core.Output)
+	for _, output := range outputs {
+		hash := output.Hash.String()
+		byHash[hash] = append(byHash[hash], output)
+	}
+
+	if len(byHash) == 0 {
+		return nil
+	}
+
+	hashes := make([]string, 0, len(byHash))
+	for hash := range byHash {
+		hashes = append(hashes, hash)
+	}
+
+	b := sq.Select(scanColumns...).
+		From("transfers").
+		Where(sq.Eq{"tx_hash": hashes}).
+		Where("internal = ? AND output_sequence IS NULL", true)
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var transfers []*core.Transfer
+	for rows.Next() {
+		var transfer core.Transfer
+		if err := scanTransfer(rows, &transfer); err != nil {
+			rows.Close()
+			return err
+		}
+
+		transfers = append(transfers, &transfer)
+	}
+
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, transfer := range transfers {
+		output := receiverOutput(transfer, byHash[transfer.TxHash])
+		if output == nil {
+			continue
+		}
+
+		b := sq.Update("transfers").
+			Set("output_sequence", output.Sequence).
+			Where("id = ? AND output_sequence IS NULL", transfer.ID)
+		if _, err := b.RunWith(s.db).ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiverOutput picks the output of the transaction paid to the opponent of
+// the transfer. The receiver isn't always at index 0, and the change of a
+// transfer to its own wallet goes to the same user, so the amount must match.
+func receiverOutput(transfer *core.Transfer, outputs []*core.Output) *core.Output {
+	members := transfer.Opponent.Members()
+	if len(members) != 1 {
+		return nil
+	}
+
+	var found *core.Output
+	for _, output := range outputs {
+		if output.UserID != members[0] || !output.Amount.Equal(transfer.Amount) {
+			continue
+		}
+
+		if found == nil || output.Index < found.Index {
+			found = output
+		}
+	}
+
+	return found
+}
+
+// updateHeld updates the held transfer, the lock fails once it's captured,
+// released or expired
+func updateHeld(ctx context.Context, r sq.BaseRunner, b sq.UpdateBuilder) error {
+	result, err := b.RunWith(r).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return core.ErrTransferNotHeld
+	}
+
+	return nil
+}
+
+// Capture checks the expiry by the database clock, like the releaser lists
+// the expired holds. The outputs of the hold are assigned already, they are
+// not counted in the backing of the virtual balances.
+func (s *transferStore) Capture(ctx context.Context, transfer *core.Transfer, amount decimal.Decimal) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := checkBacking(ctx, tx, transfer, decimal.Zero); err != nil {
+		return err
+	}
+
+	b := sq.Update("transfers").
+		Set("status", core.TransferStatusAssigned).
+		Set("amount", amount).
+		Where("id = ? AND status = ?", transfer.ID, core.TransferStatusHeld).
+		Where("hold_expires > NOW()")
+
+	if err := updateHeld(ctx, tx, b); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	transfer.Status = core.TransferStatusAssigned
+	transfer.Amount = amount
+	return nil
+}
+
+func (s *transferStore) Release(ctx context.Context, transfer *core.Transfer) (*core.Transfer, error) {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := store.Fence(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	b := sq.Update("transfers").
+		Set("status", core.TransferStatusReleased).
+		Where("id = ? AND status = ?", transfer.ID, core.TransferStatusHeld)
+
+	if err := updateHeld(ctx, tx, b); err != nil {
+		return nil, err
+	}
+
+	release := transfer.ReleaseTransfer()
+	if err := insert(ctx, tx, release); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	transfer.Status = core.TransferStatusReleased
+	return release, nil
+}
+
+func (s *transferStore) ListExpiredHolds(ctx context.Context, limit int) ([]*core.Transfer, error) {
+	b := sq.Select(scanColumns...).
+		From("transfers").
+		Where("status = ? AND hold_expires <= NOW()", core.TransferStatusHeld).
+		OrderBy("hold_expires").
+		Limit(uint64(limit))
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var transfers []*core.Transfer
+	for rows.Next() {
+		var transfer core.Transfer
+		if err := scanTransfer(rows, &transfer); err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, &transfer)
+	}
+
+	return transfers, rows.Err()
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
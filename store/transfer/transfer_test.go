@@ -0,0 +1,71 @@
+package transfer
+
+import (
+	"testing"
+
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+func TestReceiverOutput(t *testing.T) {
+	const (
+		wallet = "9e8a6a1b-58a0-4b9d-b5f4-44b4b1b5ce3c"
+		master = "2bb9a2c4-7f1a-4a6e-9d46-6e2d9c7c9b10"
+	)
+
+	testCases := []struct {
+		name     string
+		opponent string
+		amount   string
+		outputs  []*core.Output
+		want     uint64
+	}{
+		{
+			name:     "receiver after change",
+			opponent: master,
+			amount:   "1",
+			outputs: []*core.Output{
+				{Sequence: 10, Index: 0, UserID: wallet, Amount: decimal.RequireFromString("0.5")},
+				{Sequence: 11, Index: 1, UserID: master, Amount: decimal.RequireFromString("1")},
+			},
+			want: 11,
+		},
+		{
+			name:     "change to the same wallet",
+			opponent: wallet,
+			amount:   "1",
+			outputs: []*core.Output{
+				{Sequence: 10, Index: 0, UserID: wallet, Amount: decimal.RequireFromString("0.5")},
+				{Sequence: 11, Index: 1, UserID: wallet, Amount: decimal.RequireFromString("1")},
+			},
+			want: 11,
+		},
+		{
+			name:     "receiver not synced",
+			opponent: master,
+			amount:   "1",
+			outputs: []*core.Output{
+				{Sequence: 10, Index: 1, UserID: wallet, Amount: decimal.RequireFromString("0.5")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transfer := &core.Transfer{
+				Amount:   decimal.RequireFromString(tc.amount),
+				Opponent: mixin.RequireNewMixAddress([]string{tc.opponent}, 1),
+			}
+
+			var got uint64
+			if output := receiverOutput(transfer, tc.outputs); output != nil {
+				got = output.Sequence
+			}
+
+			if got != tc.want {
+				t.Errorf("receiver output = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package wallet
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func insertEvent(ctx context.Context, r sq.BaseRunner, event *core.WalletEvent) error {
+	b := sq.Insert("wallet_events").
+		Columns("user_id", "from_status", "to_status", "actor", "reason").
+		Values(event.UserID, event.From, event.To, event.Actor, event.Reason)
+	_, err := b.RunWith(r).ExecContext(ctx)
+	return err
+}
+
+func (s *walletStore) ListEvents(ctx context.Context, userID string) ([]*core.WalletEvent, error) {
+	b := sq.Select("id", "created_at", "user_id", "from_status", "to_status", "actor", "reason").
+		From("wallet_events").
+		Where("user_id = ?", userID).
+		OrderBy("id")
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var events []*core.WalletEvent
+	for rows.Next() {
+		var event core.WalletEvent
+		if err := rows.Scan(&event.ID, &event.CreatedAt, &event.UserID, &event.From, &event.To, &event.Actor, &event.Reason); err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
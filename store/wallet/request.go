@@ -0,0 +1,60 @@
+package wallet
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/generic"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+)
+
+// reserveTimeout is how long a request holds its reservation while the wallet
+// is being created, a crashed request can take it over again after that
+const reserveTimeout = "INTERVAL 1 MINUTE"
+
+func (s *walletStore) Reserve(ctx context.Context, wallet *core.Wallet) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	var stale bool
+	err := sq.Select("reserved_at < NOW() - "+reserveTimeout).
+		From("wallet_requests").
+		Where("request_id = ?", wallet.RequestID).
+		Suffix("FOR UPDATE").
+		RunWith(tx).QueryRowContext(ctx).Scan(&stale)
+
+	switch {
+	case err == nil && !stale:
+		return core.ErrWalletReserved
+	case err == nil:
+		if _, err := sq.Update("wallet_requests").
+			Set("external_id", nullString(wallet.ExternalID)).
+			Set("reserved_at", sq.Expr("NOW()")).
+			Where("request_id = ?", wallet.RequestID).
+			RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	case store.IsErrNotFound(err):
+		if wallet.ExternalID != "" {
+			// free the external id held by an abandoned request that never got a wallet
+			if _, err := sq.Delete("wallet_requests").
+				Where("external_id = ? AND reserved_at < NOW() - "+reserveTimeout, wallet.ExternalID).
+				Where("request_id NOT IN (SELECT request_id FROM wallets WHERE request_id IS NOT NULL)").
+				RunWith(tx).ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+
+		if _, err := sq.Insert("wallet_requests").
+			Columns("request_id", "external_id", "reserved_at").
+			Values(wallet.RequestID, nullString(wallet.ExternalID), sq.Expr("NOW()")).
+			RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return tx.Commit()
+}
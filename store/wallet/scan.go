@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// infoColumns are the wallet columns without the keystore
+var infoColumns = []string{
+	"id",
+	"created_at",
+	"user_id",
+	"request_id",
+	"status",
+	"label",
+	"external_id",
+	"metadata",
+}
+
+var scanColumns = append(infoColumns[:len(infoColumns):len(infoColumns)],
+	"session_id",
+	"pin_token",
+	"pin",
+	"private_key",
+	"spend_key",
+)
+
+func prefixColumns(table string, columns []string) []string {
+	prefixed := make([]string, len(columns))
+	for idx, column := range columns {
+		prefixed[idx] = table + "." + column
+	}
+
+	return prefixed
+}
+
+func scanWalletInfo(row sq.RowScanner, wallet *core.Wallet, dest ...any) error {
+	var (
+		requestID  sql.NullString
+		externalID sql.NullString
+		metadata   []byte
+	)
+
+	dest = append([]any{
+		&wallet.ID,
+		&wallet.CreatedAt,
+		&wallet.UserID,
+		&requestID,
+		&wallet.Status,
+		&wallet.Label,
+		&externalID,
+		&metadata,
+	}, dest...)
+
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+
+	wallet.RequestID = requestID.String
+	wallet.ExternalID = externalID.String
+	wallet.Metadata = metadata
+	return nil
+}
+
+func decodeWallet(row sq.RowScanner, key []byte) (*core.Wallet, error) {
+	var (
+		wallet                          core.Wallet
+		encryptedPin, encryptedSpendKey string
+	)
+
+	err := scanWalletInfo(row, &wallet, &wallet.SessionID, &wallet.PinToken, &encryptedPin, &wallet.PrivateKey, &encryptedSpendKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet.Pin, err = decrypt(key, encryptedPin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt PIN: %w", err)
+	}
+
+	wallet.SpendKey, err = decrypt(key, encryptedSpendKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt SpendKey: %w", err)
+	}
+
+	return &wallet, nil
+}
@@ -6,11 +6,13 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"database/sql"
 	"encoding/base64"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pandodao/generic"
 	"github.com/pandodao/safe-wallet/core"
 	"github.com/tsenart/nap"
 )
@@ -42,9 +44,7 @@ type walletStore struct {
 	key   []byte // AES encryption key
 }
 
-var columns = []string{"user_id", "label", "session_id", "pin_token", "pin", "private_key", "spend_key"}
-
-func (s *walletStore) Create(ctx context.Context, wallet *core.Wallet) error {
+func (s *walletStore) insert(ctx context.Context, r sq.BaseRunner, wallet *core.Wallet) error {
 	encryptedPin, err := encrypt(s.key, wallet.Pin)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt PIN: %w", err)
@@ -56,13 +56,137 @@ func (s *walletStore) Create(ctx context.Context, wallet *core.Wallet) error {
 	}
 
 	b := sq.Insert("wallets").
-		Columns(columns...).
-		Values(wallet.UserID, wallet.Label, wallet.SessionID, wallet.PinToken, encryptedPin, wallet.PrivateKey, encryptedSpendKey)
+		Columns("user_id", "request_id", "status", "label", "external_id", "metadata", "session_id", "pin_token", "pin", "private_key", "spend_key").
+		Values(wallet.UserID, nullString(wallet.RequestID), wallet.Status, wallet.Label, nullString(wallet.ExternalID), nullJSON(wallet.Metadata), wallet.SessionID, wallet.PinToken, encryptedPin, wallet.PrivateKey, encryptedSpendKey)
 
-	_, err = b.RunWith(s.db).ExecContext(ctx)
+	_, err = b.RunWith(r).ExecContext(ctx)
 	return err
 }
 
+func (s *walletStore) Create(ctx context.Context, wallet *core.Wallet) error {
+	return s.insert(ctx, s.db, wallet)
+}
+
+func (s *walletStore) Pool(ctx context.Context, wallet *core.Wallet) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	if err := s.insert(ctx, tx, wallet); err != nil {
+		return err
+	}
+
+	b := sq.Insert("wallet_pool").
+		Columns("user_id").
+		Values(wallet.UserID)
+	if _, err := b.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *walletStore) Claim(ctx context.Context, wallet *core.Wallet) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	var userID string
+	if err := sq.Select("wallet_pool.user_id").
+		From("wallet_pool").
+		Join("wallets ON wallets.user_id = wallet_pool.user_id").
+		Where("wallet_pool.claimed_at IS NULL AND wallets.status = ?", core.WalletStatusActive).
+		OrderBy("wallet_pool.id").
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		RunWith(tx).QueryRowContext(ctx).Scan(&userID); err != nil {
+		return err
+	}
+
+	if _, err := sq.Update("wallet_pool").
+		Set("claimed_at", sq.Expr("NOW()")).
+		Where("user_id = ?", userID).
+		RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	if _, err := sq.Update("wallets").
+		Set("request_id", nullString(wallet.RequestID)).
+		Set("label", wallet.Label).
+		Set("external_id", nullString(wallet.ExternalID)).
+		Set("metadata", nullJSON(wallet.Metadata)).
+		Where("user_id = ?", userID).
+		RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	row := sq.Select(scanColumns...).From("wallets").Where(sq.Eq{"user_id": userID}).RunWith(tx).QueryRowContext(ctx)
+	claimed, err := decodeWallet(row, s.key)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.cache.Remove(userID)
+	*wallet = *claimed
+	return nil
+}
+
+func (s *walletStore) PoolStats(ctx context.Context) (*core.WalletPoolStats, error) {
+	b := sq.Select().
+		Column("COALESCE(SUM(wallet_pool.claimed_at IS NULL AND wallets.status = ?), 0)", core.WalletStatusActive).
+		Column("COALESCE(SUM(wallet_pool.claimed_at IS NULL AND wallets.status = ?), 0)", core.WalletStatusProvisioning).
+		Column("COALESCE(SUM(wallet_pool.created_at > NOW() - INTERVAL 1 HOUR), 0)").
+		Column("COALESCE(SUM(wallet_pool.claimed_at > NOW() - INTERVAL 1 HOUR), 0)").
+		From("wallet_pool").
+		Join("wallets ON wallets.user_id = wallet_pool.user_id").
+		Where("wallet_pool.claimed_at IS NULL OR wallet_pool.claimed_at > NOW() - INTERVAL 1 HOUR OR wallet_pool.created_at > NOW() - INTERVAL 1 HOUR")
+
+	var stats core.WalletPoolStats
+	if err := b.RunWith(s.db).QueryRowContext(ctx).Scan(&stats.Depth, &stats.Provisioning, &stats.Refilled, &stats.Claimed); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (s *walletStore) UpdateStatus(ctx context.Context, wallet *core.Wallet, event *core.WalletEvent) error {
+	tx := generic.Must(s.db.Begin())
+	defer tx.Rollback()
+
+	b := sq.Update("wallets").
+		Set("status", event.To).
+		Where("user_id = ? AND status = ?", wallet.UserID, wallet.Status)
+
+	r, err := b.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := r.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("optimistic lock failed")
+	}
+
+	event.UserID = wallet.UserID
+	event.From = wallet.Status
+	if err := insertEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.cache.Remove(wallet.UserID)
+	return nil
+}
+
 func (s *walletStore) Find(ctx context.Context, userID string) (*core.Wallet, error) {
 	if w, ok := s.cache.Get(userID); ok {
 		return w, nil
@@ -77,13 +201,91 @@ func (s *walletStore) Find(ctx context.Context, userID string) (*core.Wallet, er
 	return w, nil
 }
 
-func (s *walletStore) List(ctx context.Context) ([]*core.Wallet, error) {
-	b := sq.Select(columns...).From("wallets")
+func (s *walletStore) FindLatest(ctx context.Context, userID string) (*core.Wallet, error) {
+	b := sq.Select(scanColumns...).From("wallets").Where(sq.Eq{"user_id": userID})
+	w, err := decodeWallet(b.RunWith(s.db.Master()).QueryRowContext(ctx), s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Add(userID, w)
+	return w, nil
+}
+
+func (s *walletStore) FindRequest(ctx context.Context, requestID string) (*core.Wallet, error) {
+	b := sq.Select(scanColumns...).From("wallets").Where(sq.Eq{"request_id": requestID})
+	row := b.RunWith(s.db).QueryRowContext(ctx)
+	return decodeWallet(row, s.key)
+}
+
+func (s *walletStore) FindExternal(ctx context.Context, externalID string) (*core.Wallet, error) {
+	b := sq.Select(scanColumns...).From("wallets").Where(sq.Eq{"external_id": externalID})
+	row := b.RunWith(s.db).QueryRowContext(ctx)
+	return decodeWallet(row, s.key)
+}
+
+func (s *walletStore) List(ctx context.Context, query core.WalletQuery) ([]*core.Wallet, error) {
+	b := sq.Select(prefixColumns("wallets", infoColumns)...).
+		From("wallets").
+		Where("wallets.id > ?", query.Offset).
+		OrderBy("wallets.id").
+		Limit(uint64(query.Limit))
+
+	if query.ExcludePool {
+		b = b.LeftJoin("wallet_pool ON wallet_pool.user_id = wallets.user_id").
+			Where("wallet_pool.id IS NULL OR wallet_pool.claimed_at IS NOT NULL")
+	}
+
+	if query.Status > 0 {
+		b = b.Where("wallets.status = ?", query.Status)
+	}
+
+	if query.Label != "" {
+		b = b.Where("wallets.label = ?", query.Label)
+	}
+
+	if query.ExternalID != "" {
+		b = b.Where("wallets.external_id = ?", query.ExternalID)
+	}
+
+	if len(query.Metadata) > 0 {
+		b = b.Where("JSON_CONTAINS(wallets.metadata, ?)", string(query.Metadata))
+	}
+
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var wallets []*core.Wallet
+	for rows.Next() {
+		var wallet core.Wallet
+		if err := scanWalletInfo(rows, &wallet); err != nil {
+			return nil, err
+		}
+
+		wallets = append(wallets, &wallet)
+	}
+
+	return wallets, rows.Err()
+}
+
+func (s *walletStore) ListStatus(ctx context.Context, status core.WalletStatus, limit int) ([]*core.Wallet, error) {
+	b := sq.Select(scanColumns...).
+		From("wallets").
+		Where("status = ?", status).
+		OrderBy("id").
+		Limit(uint64(limit))
+
 	rows, err := b.RunWith(s.db).QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	defer rows.Close()
+
 	var wallets []*core.Wallet
 	for rows.Next() {
 		wallet, err := decodeWallet(rows, s.key)
@@ -93,34 +295,51 @@ func (s *walletStore) List(ctx context.Context) ([]*core.Wallet, error) {
 		wallets = append(wallets, wallet)
 	}
 
-	return wallets, nil
+	return wallets, rows.Err()
 }
 
-func (s *walletStore) find(ctx context.Context, userID string) (*core.Wallet, error) {
-	b := sq.Select(columns...).From("wallets").Where(sq.Eq{"user_id": userID})
-	row := b.RunWith(s.db).QueryRowContext(ctx)
-	return decodeWallet(row, s.key)
-}
+func (s *walletStore) ListInactive(ctx context.Context) ([]string, error) {
+	b := sq.Select("user_id").
+		From("wallets").
+		Where(sq.Eq{"status": []core.WalletStatus{core.WalletStatusFrozen, core.WalletStatusArchived}}).
+		Suffix("UNION SELECT wallet_id FROM escrows")
 
-func decodeWallet(row sq.RowScanner, key []byte) (*core.Wallet, error) {
-	var wallet core.Wallet
-	var encryptedPin, encryptedSpendKey string
-	err := row.Scan(&wallet.UserID, &wallet.Label, &wallet.SessionID, &wallet.PinToken, &encryptedPin, &wallet.PrivateKey, &encryptedSpendKey)
+	rows, err := b.RunWith(s.db).QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	wallet.Pin, err = decrypt(key, encryptedPin)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt PIN: %w", err)
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
 	}
 
-	wallet.SpendKey, err = decrypt(key, encryptedSpendKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt SpendKey: %w", err)
+	return ids, rows.Err()
+}
+
+func (s *walletStore) find(ctx context.Context, userID string) (*core.Wallet, error) {
+	b := sq.Select(scanColumns...).From("wallets").Where(sq.Eq{"user_id": userID})
+	row := b.RunWith(s.db).QueryRowContext(ctx)
+	return decodeWallet(row, s.key)
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullJSON(b []byte) any {
+	if len(b) == 0 {
+		return nil
 	}
 
-	return &wallet, nil
+	return string(b)
 }
 
 // Encryption and decryption helper functions
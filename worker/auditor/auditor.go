@@ -0,0 +1,277 @@
+package auditor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+type Config struct {
+	// Interval is the pause between two full passes over the outputs table
+	Interval time.Duration `valid:"required"`
+	// BatchSize is the number of outputs compared with one network request
+	BatchSize int `valid:"required"`
+	// MissingChecks is the number of consecutive passes an output has to be
+	// missing on the network before it is quarantined
+	MissingChecks int `valid:"required"`
+}
+
+func New(
+	outputs core.OutputStore,
+	outputz core.OutputService,
+	logger *slog.Logger,
+	cfg Config,
+) *Auditor {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	return &Auditor{
+		outputs: outputs,
+		outputz: outputz,
+		logger:  logger.With("worker", "auditor"),
+		cfg:     cfg,
+		missing: map[uint64]int{},
+	}
+}
+
+// Auditor re-verifies the stored outputs against the network and quarantines
+// the mismatched ones, so the cashier never tries to spend them
+type Auditor struct {
+	outputs core.OutputStore
+	outputz core.OutputService
+	logger  *slog.Logger
+	cfg     Config
+
+	// missing counts the consecutive passes each output wasn't found on the network
+	missing map[uint64]int
+}
+
+// Diff is a field of a stored output that doesn't match the network
+type Diff struct {
+	Sequence uint64 `json:"sequence"`
+	Hash     string `json:"hash"`
+	Index    uint8  `json:"index"`
+	Field    string `json:"field"`
+	Stored   string `json:"stored"`
+	Remote   string `json:"remote"`
+}
+
+type Report struct {
+	From        uint64  `json:"from"`
+	Next        uint64  `json:"next"`
+	Checked     int     `json:"checked"`
+	Quarantined int     `json:"quarantined"`
+	Diffs       []*Diff `json:"diffs"`
+}
+
+func (w *Auditor) Run(ctx context.Context) error {
+	w.logger.Info("auditor start")
+
+	var offset uint64
+	for {
+		dur := time.Second
+		report, err := w.audit(ctx, offset, w.cfg.BatchSize, true, w.cfg.MissingChecks)
+		if err == nil {
+			offset = report.Next
+			for _, d := range report.Diffs {
+				w.logger.Warn("output mismatch", "sequence", d.Sequence, "field", d.Field, "stored", d.Stored, "remote", d.Remote)
+			}
+
+			// a full pass is done, start over after the interval
+			if report.Checked == 0 {
+				offset, dur = 0, w.cfg.Interval
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dur):
+		}
+	}
+}
+
+// Audit compares at most limit stored outputs from the sequence with the network.
+// State changes are saved, outputs missing on the network or with different
+// amounts, assets or receivers are quarantined if quarantine is set.
+func (w *Auditor) Audit(ctx context.Context, from uint64, limit int, quarantine bool) (*Report, error) {
+	return w.audit(ctx, from, limit, quarantine, 1)
+}
+
+// audit quarantines an output missing on the network only after it was missing
+// in missingChecks consecutive passes, a lagging node doesn't lock the funds
+func (w *Auditor) audit(ctx context.Context, from uint64, limit int, quarantine bool, missingChecks int) (*Report, error) {
+	report := &Report{From: from, Next: from, Diffs: []*Diff{}}
+
+	stored, err := w.outputs.List(ctx, "", from, limit)
+	if err != nil {
+		w.logger.Error("outputs.List", "err", err)
+		return nil, err
+	}
+
+	if len(stored) == 0 {
+		return report, nil
+	}
+
+	// the remote window starts at the same sequence, it covers the stored batch
+	// unless outputs moved to later sequences, those are read one by one
+	pulled, _, err := w.outputz.Pull(ctx, stored[0].Sequence, limit)
+	if err != nil {
+		w.logger.Error("outputz.Pull", "err", err)
+		return nil, err
+	}
+
+	remotes := make(map[string]*core.Output, len(pulled))
+	for _, output := range pulled {
+		remotes[outputKey(output)] = output
+	}
+
+	var (
+		advanced    []*core.Output
+		quarantined []uint64
+	)
+
+	for _, output := range stored {
+		report.Next = output.Sequence + 1
+
+		if output.State == core.OutputStateQuarantined {
+			continue
+		}
+
+		report.Checked++
+
+		remote, ok := remotes[outputKey(output)]
+		if !ok {
+			if remote, err = w.outputz.Find(ctx, output.Hash, output.Index); err != nil {
+				w.logger.Error("outputz.Find", "err", err)
+				return nil, err
+			}
+		}
+
+		diffs := compare(output, remote)
+		report.Diffs = append(report.Diffs, diffs...)
+
+		if remote != nil {
+			delete(w.missing, output.Sequence)
+		}
+
+		switch {
+		case len(diffs) == 0:
+		case remote == nil:
+			if w.missing[output.Sequence]++; w.missing[output.Sequence] >= missingChecks {
+				delete(w.missing, output.Sequence)
+				quarantined = append(quarantined, output.Sequence)
+			}
+		case diffs[0].Field == "state":
+			if remote.State > output.State {
+				advanced = append(advanced, remote)
+			}
+		default:
+			quarantined = append(quarantined, output.Sequence)
+		}
+	}
+
+	if len(advanced) > 0 {
+		if _, err := w.outputs.Save(ctx, advanced); err != nil {
+			w.logger.Error("outputs.Save", "err", err)
+			return nil, err
+		}
+	}
+
+	if quarantine && len(quarantined) > 0 {
+		if err := w.outputs.Quarantine(ctx, quarantined...); err != nil {
+			w.logger.Error("outputs.Quarantine", "err", err)
+			return nil, err
+		}
+
+		report.Quarantined = len(quarantined)
+	}
+
+	return report, nil
+}
+
+// Release puts a quarantined output back in service once it matches the network
+// again, it takes the state of the remote output
+func (w *Auditor) Release(ctx context.Context, sequence uint64) error {
+	output, err := w.outputs.Find(ctx, sequence)
+	if err != nil {
+		w.logger.Error("outputs.Find", "err", err)
+		return err
+	}
+
+	if output.State != core.OutputStateQuarantined {
+		return fmt.Errorf("output %d is %s, not quarantined", sequence, output.State)
+	}
+
+	remote, err := w.outputz.Find(ctx, output.Hash, output.Index)
+	if err != nil {
+		w.logger.Error("outputz.Find", "err", err)
+		return err
+	}
+
+	// compare the fields with the remote state, the diff is reported only if
+	// the output itself differs
+	current := *output
+	if remote != nil {
+		current.State = remote.State
+	}
+
+	if diffs := compare(&current, remote); len(diffs) > 0 {
+		d := diffs[0]
+		return fmt.Errorf("output %d mismatch: %s stored %s, remote %s", sequence, d.Field, d.Stored, d.Remote)
+	}
+
+	if err := w.outputs.Unquarantine(ctx, sequence, remote.State); err != nil {
+		w.logger.Error("outputs.Unquarantine", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func outputKey(output *core.Output) string {
+	return fmt.Sprintf("%s:%d", output.Hash, output.Index)
+}
+
+// compare returns the mismatched fields, a state diff is only returned if all
+// the other fields match
+func compare(stored, remote *core.Output) []*Diff {
+	diff := func(field, s, r string) *Diff {
+		return &Diff{
+			Sequence: stored.Sequence,
+			Hash:     stored.Hash.String(),
+			Index:    stored.Index,
+			Field:    field,
+			Stored:   s,
+			Remote:   r,
+		}
+	}
+
+	if remote == nil {
+		return []*Diff{diff("output", "found", "missing")}
+	}
+
+	var diffs []*Diff
+	if stored.AssetID != remote.AssetID {
+		diffs = append(diffs, diff("asset_id", stored.AssetID, remote.AssetID))
+	}
+
+	if !stored.Amount.Equal(remote.Amount) {
+		diffs = append(diffs, diff("amount", stored.Amount.String(), remote.Amount.String()))
+	}
+
+	if stored.UserID != remote.UserID {
+		diffs = append(diffs, diff("user_id", stored.UserID, remote.UserID))
+	}
+
+	if len(diffs) == 0 && stored.State != remote.State {
+		diffs = append(diffs, diff("state", stored.State.String(), remote.State.String()))
+	}
+
+	return diffs
+}
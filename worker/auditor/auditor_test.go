@@ -0,0 +1,189 @@
+package auditor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/fox-one/mixin-sdk-go/v2/mixinnet"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+func TestCompare(t *testing.T) {
+	stored := &core.Output{
+		Sequence: 1,
+		UserID:   "u1",
+		AssetID:  "a1",
+		Amount:   decimal.RequireFromString("1.5"),
+		State:    core.OutputStateUnspent,
+	}
+
+	testCases := []struct {
+		name   string
+		remote *core.Output
+		fields []string
+	}{
+		{"Missing", nil, []string{"output"}},
+		{"Same", &core.Output{UserID: "u1", AssetID: "a1", Amount: decimal.RequireFromString("1.50"), State: core.OutputStateUnspent}, nil},
+		{"Spent", &core.Output{UserID: "u1", AssetID: "a1", Amount: decimal.RequireFromString("1.5"), State: core.OutputStateSpent}, []string{"state"}},
+		{"Amount", &core.Output{UserID: "u1", AssetID: "a1", Amount: decimal.RequireFromString("2"), State: core.OutputStateSpent}, []string{"amount"}},
+		{"Receiver & asset", &core.Output{UserID: "u2", AssetID: "a2", Amount: decimal.RequireFromString("1.5")}, []string{"asset_id", "user_id"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diffs := compare(stored, tc.remote)
+			if len(diffs) != len(tc.fields) {
+				t.Fatalf("expected %d diffs, got %d", len(tc.fields), len(diffs))
+			}
+
+			for i, d := range diffs {
+				if d.Field != tc.fields[i] {
+					t.Errorf("expected field %s, got %s", tc.fields[i], d.Field)
+				}
+			}
+		})
+	}
+}
+
+type fakeOutputs struct {
+	core.OutputStore
+	outputs map[uint64]*core.Output
+}
+
+func (s *fakeOutputs) Find(_ context.Context, sequence uint64) (*core.Output, error) {
+	output := *s.outputs[sequence]
+	return &output, nil
+}
+
+func (s *fakeOutputs) List(_ context.Context, _ string, offset uint64, limit int) ([]*core.Output, error) {
+	var outputs []*core.Output
+	for seq := offset; seq < offset+uint64(limit); seq++ {
+		if output, ok := s.outputs[seq]; ok {
+			c := *output
+			outputs = append(outputs, &c)
+		}
+	}
+
+	return outputs, nil
+}
+
+func (s *fakeOutputs) Save(_ context.Context, outputs []*core.Output) (int, error) {
+	for _, output := range outputs {
+		s.outputs[output.Sequence].State = output.State
+	}
+
+	return 0, nil
+}
+
+func (s *fakeOutputs) Quarantine(_ context.Context, sequences ...uint64) error {
+	for _, seq := range sequences {
+		s.outputs[seq].State = core.OutputStateQuarantined
+	}
+
+	return nil
+}
+
+func (s *fakeOutputs) Unquarantine(_ context.Context, sequence uint64, state core.OutputState) error {
+	s.outputs[sequence].State = state
+	return nil
+}
+
+type fakeNetwork struct {
+	core.OutputService
+	outputs map[mixinnet.Hash]*core.Output
+}
+
+func (s *fakeNetwork) Pull(context.Context, uint64, int) ([]*core.Output, uint64, error) {
+	return nil, 0, nil
+}
+
+func (s *fakeNetwork) Find(_ context.Context, hash mixinnet.Hash, _ uint8) (*core.Output, error) {
+	if output, ok := s.outputs[hash]; ok {
+		c := *output
+		return &c, nil
+	}
+
+	return nil, nil
+}
+
+func TestAuditMissing(t *testing.T) {
+	ctx := context.Background()
+	output := &core.Output{
+		Sequence: 1,
+		Hash:     mixinnet.Hash{1},
+		UserID:   "u1",
+		AssetID:  "a1",
+		Amount:   decimal.NewFromInt(1),
+		State:    core.OutputStateUnspent,
+	}
+
+	outputs := &fakeOutputs{outputs: map[uint64]*core.Output{1: output}}
+	network := &fakeNetwork{outputs: map[mixinnet.Hash]*core.Output{}}
+	w := New(outputs, network, slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		Interval:      time.Hour,
+		BatchSize:     10,
+		MissingChecks: 2,
+	})
+
+	// the first miss is tolerated, the node may lag behind
+	if _, err := w.audit(ctx, 0, 10, true, w.cfg.MissingChecks); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.State != core.OutputStateUnspent {
+		t.Fatalf("expected unspent after one miss, got %s", output.State)
+	}
+
+	// found again, the count starts over
+	remote := *output
+	network.outputs[output.Hash] = &remote
+	if _, err := w.audit(ctx, 0, 10, true, w.cfg.MissingChecks); err != nil {
+		t.Fatal(err)
+	}
+
+	delete(network.outputs, output.Hash)
+	if _, err := w.audit(ctx, 0, 10, true, w.cfg.MissingChecks); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.State != core.OutputStateUnspent {
+		t.Fatalf("expected unspent after the count reset, got %s", output.State)
+	}
+
+	if _, err := w.audit(ctx, 0, 10, true, w.cfg.MissingChecks); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.State != core.OutputStateQuarantined {
+		t.Fatalf("expected quarantined after two misses, got %s", output.State)
+	}
+
+	// released only once the network knows the output with the same fields
+	if err := w.Release(ctx, 1); err == nil {
+		t.Fatal("expected release of a missing output to fail")
+	}
+
+	remote.Amount = decimal.NewFromInt(2)
+	network.outputs[output.Hash] = &remote
+	if err := w.Release(ctx, 1); err == nil {
+		t.Fatal("expected release of a mismatched output to fail")
+	}
+
+	remote.Amount = output.Amount
+	remote.State = core.OutputStateSpent
+	if err := w.Release(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.State != core.OutputStateSpent {
+		t.Fatalf("expected the remote state spent, got %s", output.State)
+	}
+
+	if err := w.Release(ctx, 1); err == nil {
+		t.Fatal("expected release of an output not quarantined to fail")
+	}
+}
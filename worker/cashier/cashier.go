@@ -2,33 +2,50 @@ package cashier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/pandodao/safe-wallet/core"
 	"golang.org/x/sync/errgroup"
 )
 
+type Config struct {
+	// Holder identifies this replica in the transfer claims
+	Holder string `valid:"required"`
+	// ClaimTTL is how long a claimed transfer is reserved, a failed transfer is retried after it
+	ClaimTTL time.Duration `valid:"required"`
+}
+
 func New(
 	outputs core.OutputStore,
 	transfers core.TransferStore,
 	loader core.ServiceLoader,
 	logger *slog.Logger,
+	cfg Config,
 ) *Cashier {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
 	return &Cashier{
 		outputs:   outputs,
 		transfers: transfers,
 		loader:    loader,
 		logger:    logger.With("worker", "cashier"),
+		cfg:       cfg,
 	}
 }
 
+// Cashier spends the assigned transfers, replicas share the work by claiming transfers one by one
 type Cashier struct {
 	outputs   core.OutputStore
 	transfers core.TransferStore
 	loader    core.ServiceLoader
 	logger    *slog.Logger
+	cfg       Config
 }
 
 func (w *Cashier) Run(ctx context.Context) error {
@@ -66,6 +83,20 @@ func (w *Cashier) run(ctx context.Context) error {
 	for idx := range transfers {
 		transfer := transfers[idx]
 		g.Go(func() error {
+			// claimed once a slot is free, the transfers waiting for a slot are left to other replicas
+			ok, err := w.transfers.Claim(ctx, transfer, w.cfg.Holder, w.cfg.ClaimTTL)
+			if err != nil {
+				w.logger.Error("transfers.Claim", "err", err, "transfer", transfer.TraceID)
+				return err
+			}
+
+			if !ok {
+				return nil
+			}
+
+			ctx, cancel := w.hold(ctx, transfer)
+			defer cancel()
+
 			return w.handleTransfer(ctx, transfer)
 		})
 	}
@@ -73,13 +104,59 @@ func (w *Cashier) run(ctx context.Context) error {
 	return g.Wait()
 }
 
+// hold renews the claim of the transfer every third of the ttl while it's
+// handled. The returned context is cancelled once the claim is lost or can't be
+// renewed before it expires, so the transfer isn't sent while another replica
+// may have claimed it.
+func (w *Cashier) hold(ctx context.Context, transfer *core.Transfer) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	logger := w.logger.With("transfer", transfer.TraceID)
+
+	go func() {
+		defer cancel()
+
+		deadline := time.Now().Add(w.cfg.ClaimTTL)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.cfg.ClaimTTL / 3):
+			}
+
+			start := time.Now()
+			ok, err := w.transfers.Claim(ctx, transfer, w.cfg.Holder, w.cfg.ClaimTTL)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				logger.Error("transfers.Claim", "err", err)
+				if time.Now().After(deadline) {
+					logger.Warn("claim expired")
+					return
+				}
+			case !ok:
+				logger.Warn("claim lost")
+				return
+			default:
+				deadline = start.Add(w.cfg.ClaimTTL)
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
+
 func (w *Cashier) handleTransfer(ctx context.Context, transfer *core.Transfer) error {
 	logger := w.logger.With("transfer", transfer.TraceID)
 
 	logger.Info("handle transfer", "asset", transfer.AssetID, "amount", transfer.Amount)
 
 	outputs, err := w.outputs.ListRange(ctx, transfer.UserID, transfer.AssetID, transfer.AssignRange[0], transfer.AssignRange[1])
-	if err != nil {
+	if errors.Is(err, core.ErrOutputQuarantined) {
+		// quarantined outputs are never spent, the transfer waits until they are released
+		logger.Warn("transfer blocked by quarantined outputs", "err", err)
+		return err
+	} else if err != nil {
 		logger.Error("outputs.ListRange", "err", err)
 		return err
 	}
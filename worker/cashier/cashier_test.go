@@ -0,0 +1,86 @@
+package cashier
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// claims answers the claim renewals in order, the last answer repeats
+type claims struct {
+	core.TransferStore
+
+	mu      sync.Mutex
+	answers []error
+	renewed int
+}
+
+var errLost = errors.New("lost")
+
+func (c *claims) Claim(context.Context, *core.Transfer, string, time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.answers[min(c.renewed, len(c.answers)-1)]
+	c.renewed++
+
+	if errors.Is(err, errLost) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func newCashier(transfers core.TransferStore, ttl time.Duration) *Cashier {
+	return New(nil, transfers, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		Holder:   "test",
+		ClaimTTL: ttl,
+	})
+}
+
+func TestHoldRenewsClaim(t *testing.T) {
+	store := &claims{answers: []error{nil}}
+	ctx, cancel := newCashier(store, 30*time.Millisecond).hold(context.Background(), &core.Transfer{})
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("held claim cancelled")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.renewed < 2 {
+		t.Fatalf("claim renewed %d times", store.renewed)
+	}
+}
+
+func TestHoldCancelsLostClaim(t *testing.T) {
+	testCases := []struct {
+		name    string
+		answers []error
+	}{
+		{"taken over", []error{nil, errLost}},
+		{"not renewed before expiry", []error{errors.New("db down")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := newCashier(&claims{answers: tc.answers}, 30*time.Millisecond).hold(context.Background(), &core.Transfer{})
+			defer cancel()
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+				t.Fatal("lost claim not cancelled")
+			}
+		})
+	}
+}
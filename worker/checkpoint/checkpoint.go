@@ -0,0 +1,90 @@
+// Package checkpoint keeps the progress of the workers in properties. The writes
+// are compare-and-set, and fenced by the lease of the leader running them, so
+// concurrent or stale workers fail instead of overwriting each other.
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+// Cursor is an offset that only moves forward from the version it was loaded at
+type Cursor struct {
+	properties core.PropertyStore
+	key        string
+	offset     uint64
+	version    uint64
+}
+
+func NewCursor(properties core.PropertyStore, key string) *Cursor {
+	return &Cursor{properties: properties, key: key}
+}
+
+// Load reads the cursor and remembers its version for the next Advance
+func (c *Cursor) Load(ctx context.Context) (uint64, error) {
+	var offset uint64
+	version, err := c.properties.GetVersioned(ctx, c.key, &offset)
+	if err != nil {
+		return 0, err
+	}
+
+	c.offset, c.version = offset, version
+	return offset, nil
+}
+
+// Advance moves the cursor to offset, it fails with core.ErrVersionConflict if
+// the cursor was written since it was loaded, or core.ErrLeaseLost if the lease
+// of the context is no longer held
+func (c *Cursor) Advance(ctx context.Context, offset uint64) error {
+	if offset < c.offset {
+		return fmt.Errorf("cursor %s can not move back from %d to %d", c.key, c.offset, offset)
+	}
+
+	version, err := c.properties.CompareAndSet(ctx, c.key, c.version, offset)
+	if err != nil {
+		return err
+	}
+
+	c.offset, c.version = offset, version
+	return nil
+}
+
+// Counter is an integer updated with compare-and-set
+type Counter struct {
+	properties core.PropertyStore
+	key        string
+}
+
+func NewCounter(properties core.PropertyStore, key string) *Counter {
+	return &Counter{properties: properties, key: key}
+}
+
+func (c *Counter) Load(ctx context.Context) (int64, error) {
+	var n int64
+	err := c.properties.Get(ctx, c.key, &n)
+	return n, err
+}
+
+// Add adds delta to the counter and returns the new value, it retries if
+// another writer updates the counter in between
+func (c *Counter) Add(ctx context.Context, delta int64) (int64, error) {
+	const maxRetry = 10
+
+	for i := 0; ; i++ {
+		var n int64
+		version, err := c.properties.GetVersioned(ctx, c.key, &n)
+		if err != nil {
+			return 0, err
+		}
+
+		n += delta
+		if _, err := c.properties.CompareAndSet(ctx, c.key, version, n); err == nil {
+			return n, nil
+		} else if !errors.Is(err, core.ErrVersionConflict) || i >= maxRetry {
+			return 0, err
+		}
+	}
+}
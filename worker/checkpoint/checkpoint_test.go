@@ -0,0 +1,66 @@
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store/property"
+	"github.com/pandodao/safe-wallet/store/storetest"
+)
+
+func TestCursor(t *testing.T) {
+	properties := property.New(storetest.Open(t))
+	ctx := context.Background()
+	key := "test:" + uuid.NewString()
+
+	a, b := NewCursor(properties, key), NewCursor(properties, key)
+	for _, c := range []*Cursor{a, b} {
+		if offset, err := c.Load(ctx); err != nil || offset != 0 {
+			t.Fatalf("load a new cursor: %d, %v", offset, err)
+		}
+	}
+
+	if err := a.Advance(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	// b was loaded before a moved, it must reload first
+	if err := b.Advance(ctx, 20); !errors.Is(err, core.ErrVersionConflict) {
+		t.Fatalf("advance a stale cursor: %v", err)
+	}
+
+	if offset, err := b.Load(ctx); err != nil || offset != 10 {
+		t.Fatalf("reload the cursor: %d, %v", offset, err)
+	}
+
+	if err := b.Advance(ctx, 5); err == nil {
+		t.Fatal("cursor moved back")
+	}
+
+	if err := b.Advance(ctx, 20); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCounterRace(t *testing.T) {
+	counter := NewCounter(property.New(storetest.Open(t)), "test:"+uuid.NewString())
+	ctx := context.Background()
+
+	const adders = 8
+
+	for _, err := range storetest.Race(adders, func(int) error {
+		_, err := counter.Add(ctx, 2)
+		return err
+	}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := counter.Load(ctx); err != nil || n != 2*adders {
+		t.Fatalf("counter is %d after %d adds: %v", n, adders, err)
+	}
+}
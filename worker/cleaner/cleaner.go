@@ -15,12 +15,21 @@ import (
 
 type Config struct {
 	Capacity int `valid:"required"`
+	// SpentRetention is how long spent outputs are kept before deleted
+	SpentRetention time.Duration
+	// RateBucketRetention is how long idle rate limit buckets are kept, longer
+	// than any bucket takes to refill
+	RateBucketRetention time.Duration `valid:"required"`
+	// DepositRetention is how long deposits can be refunded after they are synced
+	DepositRetention time.Duration `valid:"required"`
 }
 
 type Cleaner struct {
 	outputs   core.OutputStore
-	outputz   core.OutputService
 	transfers core.TransferStore
+	wallets   core.WalletStore
+	nonces    core.NonceStore
+	buckets   core.RateBucketStore
 	logger    *slog.Logger
 	cfg       Config
 }
@@ -28,7 +37,9 @@ type Cleaner struct {
 func New(
 	outputs core.OutputStore,
 	transfers core.TransferStore,
-	outputz core.OutputService,
+	wallets core.WalletStore,
+	nonces core.NonceStore,
+	buckets core.RateBucketStore,
 	logger *slog.Logger,
 	cfg Config,
 ) *Cleaner {
@@ -39,7 +50,9 @@ func New(
 	return &Cleaner{
 		outputs:   outputs,
 		transfers: transfers,
-		outputz:   outputz,
+		wallets:   wallets,
+		nonces:    nonces,
+		buckets:   buckets,
 		logger:    logger.With("worker", "cleaner"),
 		cfg:       cfg,
 	}
@@ -59,48 +72,38 @@ func (w *Cleaner) Run(ctx context.Context) error {
 }
 
 func (w *Cleaner) run(ctx context.Context) error {
-	var (
-		offset uint64
-		assets = mapset.New[string]()
-	)
+	// spent outputs are marked by the syncer, they are kept for a while so the
+	// cashier can still rebuild a transaction of an unfinished transfer
+	n, err := w.outputs.DeleteSpent(ctx, time.Now().Add(-w.cfg.SpentRetention), 500)
+	if err != nil {
+		w.logger.Error("outputs.DeleteSpent", "err", err)
+		return err
+	}
 
-	for {
-		const limit = 500
-		outputs, err := w.outputs.List(ctx, "", offset, limit)
-		if err != nil {
-			w.logger.Error("outputs.List", "err", err)
-			return err
-		}
+	if n > 0 {
+		w.logger.Debug("spent outputs deleted", "count", n)
+	}
 
-		if len(outputs) == 0 {
-			break
-		}
+	// nonces of signed requests are only needed until their timestamps are out of the skew
+	if n, err := w.nonces.DeleteExpired(ctx, 500); err != nil {
+		w.logger.Error("nonces.DeleteExpired", "err", err)
+		return err
+	} else if n > 0 {
+		w.logger.Debug("expired nonces deleted", "count", n)
+	}
 
-		for _, output := range outputs {
-			offset = output.Sequence + 1
-
-			key := fmt.Sprintf("%s-%s", output.UserID, output.AssetID)
-			if assets.Has(key) {
-				continue
-			}
-
-			outputs, _, err := w.outputz.Pull(ctx, output.Sequence, 1)
-			if err != nil {
-				w.logger.Error("outputz.Pull", "err", err)
-				return err
-			}
-
-			// output 被返回说明该 output 是 unspent 的
-			if len(outputs) > 0 && outputs[0].Sequence == output.Sequence {
-				assets.Put(key)
-				continue
-			}
-
-			if err := w.outputs.Delete(ctx, output.Sequence); err != nil {
-				w.logger.Error("outputs.Delete", "err", err)
-				return err
-			}
-		}
+	if n, err := w.outputs.DeleteDeposits(ctx, time.Now().Add(-w.cfg.DepositRetention), 500); err != nil {
+		w.logger.Error("outputs.DeleteDeposits", "err", err)
+		return err
+	} else if n > 0 {
+		w.logger.Debug("deposits deleted", "count", n)
+	}
+
+	if n, err := w.buckets.DeleteIdle(ctx, time.Now().Add(-w.cfg.RateBucketRetention), 500); err != nil {
+		w.logger.Error("buckets.DeleteIdle", "err", err)
+		return err
+	} else if n > 0 {
+		w.logger.Debug("idle rate buckets deleted", "count", n)
 	}
 
 	return w.mergeOutputs(ctx)
@@ -114,11 +117,23 @@ func (w *Cleaner) mergeOutputs(ctx context.Context) error {
 		return err
 	}
 
+	ids, err := w.wallets.ListInactive(ctx)
+	if err != nil {
+		w.logger.Error("wallets.ListInactive", "err", err)
+		return err
+	}
+
+	inactive := mapset.Of(ids...)
+
 	for _, b := range balances {
 		if b.Count <= w.cfg.Capacity {
 			continue
 		}
 
+		if inactive.Has(b.UserID) {
+			continue
+		}
+
 		offset, err := w.transfers.GetAssignOffset(ctx, b.UserID, b.AssetID)
 		if err != nil {
 			w.logger.Error("transfers.GetAssignOffset", "err", err)
@@ -136,14 +151,17 @@ func (w *Cleaner) mergeOutputs(ctx context.Context) error {
 			continue
 		}
 
+		// the trace is derived from the assign offset, a merge round is only created once
+		memo := fmt.Sprintf("merge %s %s from %d", b.UserID, b.AssetID, offset)
 		t := &core.Transfer{
 			UserID:    b.UserID,
 			CreatedAt: time.Now(),
-			TraceID:   uuid.NewString(),
+			TraceID:   uuid.NewSHA1(uuid.NameSpaceOID, []byte(memo)).String(),
 			Status:    core.TransferStatusPending,
 			AssetID:   b.AssetID,
 			Memo:      "auto merge",
 			Opponent:  mixin.RequireNewMixAddress([]string{b.UserID}, 1),
+			Internal:  true,
 		}
 
 		t.AssignRange[0] = outputs[0].Sequence
@@ -0,0 +1,107 @@
+package escrower
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func New(
+	escrows core.EscrowStore,
+	escrowz core.EscrowService,
+	logger *slog.Logger,
+) *Escrower {
+	return &Escrower{
+		escrows: escrows,
+		escrowz: escrowz,
+		logger:  logger.With("worker", "escrower"),
+	}
+}
+
+// Escrower refunds the escrows not released before the deadline, and settles
+// the decided escrows whose transfers aren't created yet
+type Escrower struct {
+	escrows core.EscrowStore
+	escrowz core.EscrowService
+	logger  *slog.Logger
+}
+
+func (w *Escrower) Run(ctx context.Context) error {
+	w.logger.Info("escrower start")
+
+	for {
+		_ = w.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
+
+func (w *Escrower) run(ctx context.Context) error {
+	if err := w.expire(ctx); err != nil {
+		return err
+	}
+
+	for _, status := range []core.EscrowStatus{core.EscrowStatusReleasing, core.EscrowStatusRefunding} {
+		if err := w.settle(ctx, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Escrower) expire(ctx context.Context) error {
+	const limit = 100
+	escrows, err := w.escrows.ListExpired(ctx, time.Now(), limit)
+	if err != nil {
+		w.logger.Error("escrows.ListExpired", "err", err)
+		return err
+	}
+
+	for _, escrow := range escrows {
+		logger := w.logger.With("trace", escrow.TraceID, "deadline", escrow.Deadline)
+
+		// released by the arbiter since listed, the lock fails and it is skipped
+		if err := w.escrows.UpdateStatus(ctx, escrow, core.EscrowStatusRefunding, "deadline"); err != nil {
+			logger.Error("escrows.UpdateStatus", "err", err)
+			continue
+		}
+
+		logger.Info("escrow expired and refunding")
+	}
+
+	return nil
+}
+
+func (w *Escrower) settle(ctx context.Context, status core.EscrowStatus) error {
+	const limit = 100
+	escrows, err := w.escrows.ListStatus(ctx, status, limit)
+	if err != nil {
+		w.logger.Error("escrows.ListStatus", "err", err)
+		return err
+	}
+
+	for _, escrow := range escrows {
+		logger := w.logger.With("trace", escrow.TraceID, "status", escrow.Status)
+
+		transfer, err := w.escrowz.Settle(ctx, escrow)
+		if errors.Is(err, core.ErrEscrowUnfunded) {
+			logger.Debug("escrow not funded yet")
+			continue
+		} else if err != nil {
+			logger.Error("escrowz.Settle", "err", err)
+			continue
+		}
+
+		logger.Info("escrow settled", "transfer", transfer.TraceID)
+	}
+
+	return nil
+}
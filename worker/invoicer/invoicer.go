@@ -0,0 +1,133 @@
+package invoicer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/pandodao/safe-wallet/worker/checkpoint"
+)
+
+const (
+	// PropertyInvoiceOffset is the property key of the last output sequence matched
+	PropertyInvoiceOffset = "invoice_offset"
+)
+
+func New(
+	outputs core.OutputStore,
+	invoices core.InvoiceStore,
+	properties core.PropertyStore,
+	logger *slog.Logger,
+) *Invoicer {
+	return &Invoicer{
+		outputs:  outputs,
+		invoices: invoices,
+		cursor:   checkpoint.NewCursor(properties, PropertyInvoiceOffset),
+		logger:   logger.With("worker", "invoicer"),
+	}
+}
+
+// Invoicer matches the deposits saved by the syncer to the invoices by memo
+type Invoicer struct {
+	outputs  core.OutputStore
+	invoices core.InvoiceStore
+	cursor   *checkpoint.Cursor
+	logger   *slog.Logger
+}
+
+func (w *Invoicer) Run(ctx context.Context) error {
+	w.logger.Info("invoicer start")
+
+	for {
+		dur := time.Second * 2
+		if w.run(ctx) == nil {
+			dur = time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dur):
+		}
+	}
+}
+
+func (w *Invoicer) run(ctx context.Context) error {
+	// deposits made before expiry still pay the expired invoices, see Invoice.Receive
+	if n, err := w.invoices.Expire(ctx, time.Now()); err != nil {
+		w.logger.Error("invoices.Expire", "err", err)
+		return err
+	} else if n > 0 {
+		w.logger.Info("invoices expired", "count", n)
+	}
+
+	offset, err := w.cursor.Load(ctx)
+	if err != nil {
+		w.logger.Error("cursor.Load", "err", err)
+		return err
+	}
+
+	// late deposits are saved after the stored outputs, the cursor doesn't skip them
+	const limit = 500
+	outputs, err := w.outputs.Query(ctx, core.OutputQuery{
+		Offset:  offset,
+		Limit:   limit,
+		Deposit: true,
+	})
+	if err != nil {
+		w.logger.Error("outputs.Query", "err", err)
+		return err
+	}
+
+	if len(outputs) == 0 {
+		return fmt.Errorf("no new outputs")
+	}
+
+	for _, output := range outputs {
+		if err := w.match(ctx, output); err != nil {
+			return err
+		}
+	}
+
+	if err := w.cursor.Advance(ctx, outputs[len(outputs)-1].Sequence); err != nil {
+		w.logger.Error("cursor.Advance", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (w *Invoicer) match(ctx context.Context, output *core.Output) error {
+	if output.Memo == "" {
+		return nil
+	}
+
+	invoice, err := w.invoices.FindMemo(ctx, output.UserID, output.Memo)
+	if err != nil {
+		if store.IsErrNotFound(err) {
+			return nil
+		}
+
+		w.logger.Error("invoices.FindMemo", "err", err)
+		return err
+	}
+
+	logger := w.logger.With("invoice", invoice.TraceID, "sequence", output.Sequence)
+
+	// paid with another asset, left to the operator to refund
+	if output.AssetID != invoice.AssetID {
+		logger.Warn("invoice paid with wrong asset", "asset", output.AssetID, "amount", output.Amount)
+		return nil
+	}
+
+	if err := w.invoices.Pay(ctx, invoice, output); err != nil {
+		logger.Error("invoices.Pay", "err", err)
+		return err
+	}
+
+	logger.Info("invoice paid", "amount", output.Amount, "paid", invoice.Paid, "status", invoice.Status)
+	return nil
+}
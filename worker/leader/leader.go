@@ -0,0 +1,125 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+type Config struct {
+	// Name is the lease name, replicas sharing it elect one leader
+	Name string `valid:"required"`
+	// Holder identifies this replica, it must be unique among the replicas
+	Holder string `valid:"required"`
+	// TTL is how long the lease survives without renewal, it is renewed every TTL/3
+	TTL time.Duration `valid:"required"`
+}
+
+func New(
+	leases core.LeaseStore,
+	logger *slog.Logger,
+	cfg Config,
+) *Elector {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	return &Elector{
+		leases: leases,
+		logger: logger.With("worker", "leader", "lease", cfg.Name, "holder", cfg.Holder),
+		cfg:    cfg,
+	}
+}
+
+// Elector runs singleton workers on one replica at a time
+type Elector struct {
+	leases core.LeaseStore
+	logger *slog.Logger
+	cfg    Config
+}
+
+// Run campaigns for the lease and runs fn while it is held. The context passed
+// to fn carries the lease and is cancelled once the lease is lost.
+func (e *Elector) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	e.logger.Info("elector start")
+
+	for {
+		start := time.Now()
+		lease, err := e.leases.Acquire(ctx, e.cfg.Name, e.cfg.Holder, e.cfg.TTL)
+		if err == nil {
+			e.lead(ctx, lease, start.Add(e.cfg.TTL), fn)
+		} else if !errors.Is(err, core.ErrLeaseHeld) && ctx.Err() == nil {
+			e.logger.Error("leases.Acquire", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.cfg.TTL / 3):
+		}
+	}
+}
+
+// lead runs fn until the lease is lost, fn returns or ctx is done. The lease
+// is counted as lost once the local deadline passes without a renewal.
+func (e *Elector) lead(ctx context.Context, lease *core.Lease, deadline time.Time, fn func(ctx context.Context) error) {
+	logger := e.logger.With("token", lease.Token)
+	logger.Info("lease acquired")
+
+	leaderCtx, cancel := context.WithCancel(core.WithLease(ctx, lease))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := fn(leaderCtx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("leader exit", "err", err)
+		}
+	}()
+
+	defer func() {
+		cancel()
+		<-done
+
+		// ctx may be done already, the lease is released anyway so other replicas needn't wait for the ttl
+		if err := e.leases.Release(context.WithoutCancel(ctx), lease); err != nil {
+			logger.Error("leases.Release", "err", err)
+			return
+		}
+
+		logger.Info("lease released")
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-time.After(e.cfg.TTL / 3):
+		}
+
+		start := time.Now()
+		renewCtx, cancelRenew := context.WithDeadline(ctx, deadline)
+		_, err := e.leases.Acquire(renewCtx, lease.Name, lease.Holder, e.cfg.TTL)
+		cancelRenew()
+
+		switch {
+		case err == nil:
+			deadline = start.Add(e.cfg.TTL)
+		case errors.Is(err, core.ErrLeaseHeld):
+			logger.Warn("lease taken over")
+			return
+		case ctx.Err() != nil:
+			return
+		default:
+			logger.Error("leases.Acquire", "err", err)
+			if time.Now().After(deadline) {
+				logger.Warn("lease expired")
+				return
+			}
+		}
+	}
+}
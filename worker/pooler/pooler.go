@@ -0,0 +1,112 @@
+package pooler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+type Config struct {
+	// Size is the number of provisioned wallets kept in the pool, 0 disables the pool
+	Size int
+	// Interval is the pause between two created wallets, it limits the refill rate
+	Interval time.Duration `valid:"required"`
+}
+
+func New(
+	wallets core.WalletStore,
+	walletz core.WalletService,
+	logger *slog.Logger,
+	cfg Config,
+) *Pooler {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	return &Pooler{
+		wallets: wallets,
+		walletz: walletz,
+		logger:  logger.With("worker", "pooler"),
+		cfg:     cfg,
+	}
+}
+
+// Pooler keeps the wallet pool filled, so CreateWallet can claim a wallet instantly
+type Pooler struct {
+	wallets core.WalletStore
+	walletz core.WalletService
+	logger  *slog.Logger
+	cfg     Config
+}
+
+func (w *Pooler) Run(ctx context.Context) error {
+	if w.cfg.Size <= 0 {
+		w.logger.Info("pooler disabled")
+		return nil
+	}
+
+	w.logger.Info("pooler start", "size", w.cfg.Size)
+
+	for {
+		dur := 10 * time.Second
+		if w.run(ctx) == nil {
+			dur = w.cfg.Interval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dur):
+		}
+	}
+}
+
+func (w *Pooler) run(ctx context.Context) error {
+	stats, err := w.wallets.PoolStats(ctx)
+	if err != nil {
+		w.logger.Error("wallets.PoolStats", "err", err)
+		return err
+	}
+
+	// wallets still provisioning are finished by the provisioner
+	if stats.Depth+stats.Provisioning >= w.cfg.Size {
+		return fmt.Errorf("pool is full")
+	}
+
+	const label = "safe-wallet"
+	wallet, err := w.walletz.Create(ctx, label)
+	if err != nil {
+		w.logger.Error("walletz.Create", "err", err)
+		return err
+	}
+
+	logger := w.logger.With("user", wallet.UserID)
+
+	wallet.RequestID = uuid.NewString()
+	if err := w.wallets.Pool(ctx, wallet); err != nil {
+		logger.Error("wallets.Pool", "err", err)
+		return err
+	}
+
+	if err := w.walletz.Provision(ctx, wallet); err != nil {
+		logger.Error("walletz.Provision", "err", err)
+		return err
+	}
+
+	if err := w.wallets.UpdateStatus(ctx, wallet, &core.WalletEvent{
+		To:     core.WalletStatusActive,
+		Actor:  "pooler",
+		Reason: "wallet provisioned",
+	}); err != nil {
+		logger.Error("wallets.UpdateStatus", "err", err)
+		return err
+	}
+
+	logger.Info("wallet pooled", "depth", stats.Depth+1)
+	return nil
+}
@@ -0,0 +1,102 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/pandodao/safe-wallet/core"
+)
+
+type Config struct {
+	// Delay skips wallets created recently, they may still be provisioned by the rpc server
+	Delay time.Duration `valid:"required"`
+	// ReportAfter reports wallets stuck in provisioning longer than it
+	ReportAfter time.Duration `valid:"required"`
+}
+
+func New(
+	wallets core.WalletStore,
+	walletz core.WalletService,
+	logger *slog.Logger,
+	cfg Config,
+) *Provisioner {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	return &Provisioner{
+		wallets: wallets,
+		walletz: walletz,
+		logger:  logger.With("worker", "provisioner"),
+		cfg:     cfg,
+	}
+}
+
+// Provisioner finishes wallets left half-provisioned by an interrupted CreateWallet
+type Provisioner struct {
+	wallets core.WalletStore
+	walletz core.WalletService
+	logger  *slog.Logger
+	cfg     Config
+}
+
+func (w *Provisioner) Run(ctx context.Context) error {
+	w.logger.Info("provisioner start")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+			_ = w.run(ctx)
+		}
+	}
+}
+
+func (w *Provisioner) run(ctx context.Context) error {
+	const limit = 100
+	wallets, err := w.wallets.ListStatus(ctx, core.WalletStatusProvisioning, limit)
+	if err != nil {
+		w.logger.Error("wallets.ListStatus", "err", err)
+		return err
+	}
+
+	if len(wallets) == 0 {
+		return fmt.Errorf("provisioning wallets dry")
+	}
+
+	for _, wallet := range wallets {
+		age := time.Since(wallet.CreatedAt)
+		if age < w.cfg.Delay {
+			continue
+		}
+
+		logger := w.logger.With("user", wallet.UserID, "request", wallet.RequestID, "age", age)
+
+		if err := w.walletz.Provision(ctx, wallet); err != nil {
+			if age > w.cfg.ReportAfter {
+				logger.Error("wallet stuck in provisioning", "err", err)
+			} else {
+				logger.Warn("walletz.Provision", "err", err)
+			}
+
+			continue
+		}
+
+		if err := w.wallets.UpdateStatus(ctx, wallet, &core.WalletEvent{
+			To:     core.WalletStatusActive,
+			Actor:  "provisioner",
+			Reason: "wallet provisioned",
+		}); err != nil {
+			logger.Error("wallets.UpdateStatus", "err", err)
+			continue
+		}
+
+		logger.Info("wallet provisioned")
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package refiller
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/fox-one/mixin-sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/store"
+	"github.com/shopspring/decimal"
+	"github.com/zyedidia/generic/mapset"
+)
+
+// stats is the refiller state exposed by expvar, the assets the master wallet
+// couldn't cover and the rules conflicting with sweep rules in the last round
+var stats = expvar.NewMap("refiller")
+
+func setGauge(name string, n int) {
+	v := new(expvar.Int)
+	v.Set(int64(n))
+	stats.Set(name, v)
+}
+
+type Config struct {
+	ClientID string `valid:"required"`
+	// Cooldown is the minimum time between two refills of the same rule, it
+	// should be longer than a transfer takes to be sent & synced
+	Cooldown time.Duration `valid:"required"`
+	// Limit is the max number of refills in one round
+	Limit int `valid:"required"`
+}
+
+func New(
+	outputs core.OutputStore,
+	transfers core.TransferStore,
+	wallets core.WalletStore,
+	topups core.TopupStore,
+	sweepz core.SweepService,
+	logger *slog.Logger,
+	cfg Config,
+) *Refiller {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
+	return &Refiller{
+		outputs:   outputs,
+		transfers: transfers,
+		wallets:   wallets,
+		topups:    topups,
+		sweepz:    sweepz,
+		logger:    logger.With("worker", "refiller"),
+		cfg:       cfg,
+	}
+}
+
+// Refiller tops up wallets from the master wallet by their topup rules
+type Refiller struct {
+	outputs   core.OutputStore
+	transfers core.TransferStore
+	wallets   core.WalletStore
+	topups    core.TopupStore
+	sweepz    core.SweepService
+	logger    *slog.Logger
+	cfg       Config
+}
+
+func (w *Refiller) Run(ctx context.Context) error {
+	w.logger.Info("refiller start", "cooldown", w.cfg.Cooldown, "limit", w.cfg.Limit)
+
+	for {
+		_ = w.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(30 * time.Second):
+		}
+	}
+}
+
+func (w *Refiller) run(ctx context.Context) error {
+	// the cooldown is checked by the database clock, refilled_at is set by it
+	rules, err := w.topups.ListDue(ctx, w.cfg.Cooldown)
+	if err != nil {
+		w.logger.Error("topups.ListDue", "err", err)
+		return err
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	balances, err := w.outputs.SumBalances(ctx, "", "")
+	if err != nil {
+		w.logger.Error("outputs.SumBalances", "err", err)
+		return err
+	}
+
+	amounts := make(map[string]decimal.Decimal, len(balances))
+	for _, b := range balances {
+		amounts[b.UserID+b.AssetID] = b.Amount
+	}
+
+	ids, err := w.wallets.ListInactive(ctx)
+	if err != nil {
+		w.logger.Error("wallets.ListInactive", "err", err)
+		return err
+	}
+
+	inactive := mapset.Of(ids...)
+
+	var (
+		refills   int
+		conflicts int
+		uncovered = mapset.New[string]()
+	)
+
+	defer func() {
+		setGauge("uncovered_assets", uncovered.Size())
+		setGauge("conflicting_rules", conflicts)
+	}()
+
+	for _, rule := range rules {
+		if refills >= w.cfg.Limit {
+			w.logger.Info("refill limit reached", "limit", w.cfg.Limit)
+			break
+		}
+
+		if rule.UserID == w.cfg.ClientID || inactive.Has(rule.UserID) {
+			continue
+		}
+
+		if sweep := w.conflictingSweep(rule); sweep != nil {
+			conflicts++
+			w.logger.Error("topup rule conflicts with sweep rule, skipped", "alert", true, "user", rule.UserID, "asset", rule.AssetID, "target", rule.Target, "sweep_min", sweep.MinAmount)
+			continue
+		}
+
+		balance := amounts[rule.UserID+rule.AssetID]
+		if balance.GreaterThanOrEqual(rule.Floor) {
+			continue
+		}
+
+		logger := w.logger.With("user", rule.UserID, "asset", rule.AssetID, "balance", balance)
+		amount := rule.Target.Sub(balance)
+
+		master := amounts[w.cfg.ClientID+rule.AssetID]
+		if master.LessThan(amount) {
+			if !uncovered.Has(rule.AssetID) {
+				uncovered.Put(rule.AssetID)
+				logger.Error("master wallet can not cover top-up", "alert", true, "master", master, "amount", amount)
+			}
+
+			continue
+		}
+
+		ok, err := w.refill(ctx, rule, amount)
+		if err != nil {
+			logger.Error("refill", "err", err)
+			continue
+		}
+
+		if ok {
+			logger.Info("wallet topped up", "amount", amount, "refills", rule.Refills+1)
+			amounts[w.cfg.ClientID+rule.AssetID] = master.Sub(amount)
+			refills++
+		}
+	}
+
+	return nil
+}
+
+// refill assigns a transfer of amount from the master wallet to the rule wallet.
+// The trace id is derived from the refill count of the rule, so a refill assigned
+// before a crash is found & counted instead of sent twice.
+func (w *Refiller) refill(ctx context.Context, rule *core.TopupRule, amount decimal.Decimal) (bool, error) {
+	memo := fmt.Sprintf("topup %s %s %d", rule.UserID, rule.AssetID, rule.Refills)
+	trace := uuid.NewSHA1(uuid.NameSpaceOID, []byte(memo))
+
+	if _, err := w.transfers.FindTrace(ctx, trace.String()); err == nil {
+		return false, w.topups.Refilled(ctx, rule)
+	} else if !store.IsErrNotFound(err) {
+		return false, err
+	}
+
+	offset, err := w.transfers.GetAssignOffset(ctx, w.cfg.ClientID, rule.AssetID)
+	if err != nil {
+		return false, err
+	}
+
+	const limit = 256
+	outputs, err := w.outputs.ListTarget(ctx, w.cfg.ClientID, rule.AssetID, offset, amount, limit)
+	if err != nil {
+		return false, err
+	}
+
+	transfer := &core.Transfer{
+		TraceID:  trace.String(),
+		Status:   core.TransferStatusPending,
+		UserID:   w.cfg.ClientID,
+		AssetID:  rule.AssetID,
+		Amount:   amount,
+		Memo:     "topup",
+		Opponent: mixin.RequireNewMixAddress([]string{rule.UserID}, 1),
+		Internal: true,
+	}
+
+	var sum decimal.Decimal
+	for _, output := range outputs {
+		sum = sum.Add(output.Amount)
+		transfer.AssignRange[1] = output.Sequence
+	}
+
+	// the master balance is fragmented, wait for the cleaner to merge the outputs
+	if sum.LessThan(amount) {
+		return false, fmt.Errorf("insufficient outputs, got %s want %s", sum, amount)
+	}
+
+	transfer.AssignRange[0] = outputs[0].Sequence
+	if err := w.transfers.Assign(ctx, transfer, offset); err != nil {
+		return false, err
+	}
+
+	if err := w.topups.Refilled(ctx, rule); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// conflictingSweep returns the sweep rule that would sweep the top-up of the
+// rule out again, the sweep rules may be changed after the rule was set
+func (w *Refiller) conflictingSweep(rule *core.TopupRule) *core.SweepRule {
+	for _, sweep := range w.sweepz.Rules() {
+		if rule.ConflictsWith(sweep) {
+			return sweep
+		}
+	}
+
+	return nil
+}
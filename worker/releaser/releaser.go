@@ -0,0 +1,66 @@
+package releaser
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func New(
+	transfers core.TransferStore,
+	logger *slog.Logger,
+) *Releaser {
+	return &Releaser{
+		transfers: transfers,
+		logger:    logger.With("worker", "releaser"),
+	}
+}
+
+// Releaser releases the held transfers not captured before they expire
+type Releaser struct {
+	transfers core.TransferStore
+	logger    *slog.Logger
+}
+
+func (w *Releaser) Run(ctx context.Context) error {
+	w.logger.Info("releaser start")
+
+	for {
+		_ = w.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
+
+func (w *Releaser) run(ctx context.Context) error {
+	const limit = 100
+	transfers, err := w.transfers.ListExpiredHolds(ctx, limit)
+	if err != nil {
+		w.logger.Error("transfers.ListExpiredHolds", "err", err)
+		return err
+	}
+
+	for _, transfer := range transfers {
+		logger := w.logger.With("trace", transfer.TraceID, "user", transfer.UserID, "expires", transfer.HoldExpires)
+
+		release, err := w.transfers.Release(ctx, transfer)
+		if errors.Is(err, core.ErrTransferNotHeld) {
+			logger.Debug("captured or released since listed")
+			continue
+		} else if err != nil {
+			logger.Error("transfers.Release", "err", err)
+			continue
+		}
+
+		logger.Info("hold expired and released", "release", release.TraceID)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/worker/checkpoint"
+)
+
+const (
+	// PropertyRouteOffset is the property key of the last output sequence routed
+	PropertyRouteOffset = "route_offset"
+)
+
+func New(
+	outputs core.OutputStore,
+	routes core.RouteStore,
+	ledger core.LedgerStore,
+	properties core.PropertyStore,
+	logger *slog.Logger,
+) *Router {
+	return &Router{
+		outputs: outputs,
+		routes:  routes,
+		ledger:  ledger,
+		cursor:  checkpoint.NewCursor(properties, PropertyRouteOffset),
+		logger:  logger.With("worker", "router"),
+	}
+}
+
+// Router credits the deposits saved by the syncer to the virtual accounts of
+// the first matching rules of their wallets
+type Router struct {
+	outputs core.OutputStore
+	routes  core.RouteStore
+	ledger  core.LedgerStore
+	cursor  *checkpoint.Cursor
+	logger  *slog.Logger
+}
+
+func (w *Router) Run(ctx context.Context) error {
+	w.logger.Info("router start")
+
+	for {
+		dur := time.Second * 2
+		if w.run(ctx) == nil {
+			dur = time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dur):
+		}
+	}
+}
+
+func (w *Router) run(ctx context.Context) error {
+	offset, err := w.cursor.Load(ctx)
+	if err != nil {
+		w.logger.Error("cursor.Load", "err", err)
+		return err
+	}
+
+	const limit = 500
+	outputs, err := w.outputs.Query(ctx, core.OutputQuery{
+		Offset:  offset,
+		Limit:   limit,
+		Deposit: true,
+	})
+	if err != nil {
+		w.logger.Error("outputs.Query", "err", err)
+		return err
+	}
+
+	if len(outputs) == 0 {
+		return fmt.Errorf("no new outputs")
+	}
+
+	// reloaded every round, rules only apply to the deposits synced after they are saved
+	rules, err := w.routes.ListRules(ctx, "")
+	if err != nil {
+		w.logger.Error("routes.ListRules", "err", err)
+		return err
+	}
+
+	walletRules := map[string][]*core.RouteRule{}
+	for _, rule := range rules {
+		if err := rule.Compile(); err != nil {
+			w.logger.Warn("invalid memo regex", "rule", rule.ID, "err", err)
+			continue
+		}
+
+		walletRules[rule.UserID] = append(walletRules[rule.UserID], rule)
+	}
+
+	// deposits of wallets without rules are routed unmatched, they can be assigned later
+	routes := make([]*core.DepositRoute, 0, len(outputs))
+	for _, output := range outputs {
+		routes = append(routes, route(walletRules[output.UserID], output))
+	}
+
+	if err := w.routes.Route(ctx, routes); err != nil {
+		w.logger.Error("routes.Route", "err", err)
+		return err
+	}
+
+	w.logger.Info("deposits routed", "count", len(routes), "offset", offset)
+
+	if err := w.credit(ctx, routes); err != nil {
+		return err
+	}
+
+	if err := w.cursor.Advance(ctx, outputs[len(outputs)-1].Sequence); err != nil {
+		w.logger.Error("cursor.Advance", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// credit posts the matched deposits to the ledger, postings are idempotent so
+// the credited ones are skipped on retries
+func (w *Router) credit(ctx context.Context, routes []*core.DepositRoute) error {
+	for _, route := range routes {
+		if route.Account == "" {
+			continue
+		}
+
+		if _, err := w.ledger.Post(ctx, route.Posting()); err != nil {
+			if errors.Is(err, core.ErrLedgerExceedsBalance) {
+				// the cursor stays, the credit is retried once the wallet's balance backs it
+				w.logger.Warn("deposit not backed, retry", "sequence", route.OutputSequence, "account", route.Account, "err", err)
+				return err
+			}
+
+			w.logger.Error("ledger.Post", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// route credits the deposit to the first matching rule, the rules are ordered
+// by priority. Deposits matching no rule are left unmatched.
+func route(rules []*core.RouteRule, output *core.Output) *core.DepositRoute {
+	r := &core.DepositRoute{
+		OutputSequence: output.Sequence,
+		UserID:         output.UserID,
+		AssetID:        output.AssetID,
+		Amount:         output.Amount,
+		Memo:           output.Memo,
+	}
+
+	for _, rule := range rules {
+		if rule.Match(output) {
+			r.Account = rule.Account
+			r.RuleID = rule.ID
+			break
+		}
+	}
+
+	return r
+}
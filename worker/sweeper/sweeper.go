@@ -0,0 +1,73 @@
+package sweeper
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pandodao/safe-wallet/core"
+)
+
+func New(
+	sweepz core.SweepService,
+	logger *slog.Logger,
+) *Sweeper {
+	return &Sweeper{
+		sweepz:  sweepz,
+		logger:  logger.With("worker", "sweeper"),
+		lastRun: make(map[string]time.Time),
+	}
+}
+
+// Sweeper runs the sweep rules on their schedule, and sweeps the deposits of
+// archived wallets every round
+type Sweeper struct {
+	sweepz  core.SweepService
+	logger  *slog.Logger
+	lastRun map[string]time.Time
+}
+
+func (w *Sweeper) Run(ctx context.Context) error {
+	w.logger.Info("sweeper start", "rules", len(w.sweepz.Rules()))
+
+	for {
+		w.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Minute):
+		}
+	}
+}
+
+func (w *Sweeper) run(ctx context.Context) {
+	for _, rule := range w.sweepz.Rules() {
+		if time.Since(w.lastRun[rule.AssetID]) < rule.Interval {
+			continue
+		}
+
+		logger := w.logger.With("asset", rule.AssetID)
+
+		transfers, err := w.sweepz.SweepAll(ctx, rule.AssetID)
+		for _, t := range transfers {
+			logger.Info("sweep assigned", "user", t.UserID, "amount", t.Amount, "trace", t.TraceID)
+		}
+
+		// the failed wallets are retried in the next round of the rule
+		if err != nil {
+			logger.Error("sweepz.SweepAll", "err", err)
+		}
+
+		w.lastRun[rule.AssetID] = time.Now()
+	}
+
+	transfers, err := w.sweepz.SweepArchived(ctx)
+	for _, t := range transfers {
+		w.logger.Info("archive sweep assigned", "user", t.UserID, "asset", t.AssetID, "amount", t.Amount, "trace", t.TraceID)
+	}
+
+	if err != nil {
+		w.logger.Error("sweepz.SweepArchived", "err", err)
+	}
+}
@@ -0,0 +1,76 @@
+package syncer
+
+import (
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+	"github.com/zyedidia/generic/mapset"
+)
+
+const (
+	UnknownAssetAccept = "accept"
+	UnknownAssetIgnore = "ignore"
+)
+
+type FilterConfig struct {
+	// Allow lists the known assets, together with the assets in MinAmounts
+	Allow []string
+	// Deny lists the assets always ignored
+	Deny []string
+	// Unknown is the policy for assets not known, accept or ignore
+	Unknown string
+	// MinAmounts is the minimum deposit amount per asset
+	MinAmounts map[string]decimal.Decimal
+	// DefaultMinAmount applies to the assets without a minimum amount
+	DefaultMinAmount decimal.Decimal
+}
+
+// filter drops spam & dust deposits before they are saved
+type filter struct {
+	allow mapset.Set[string]
+	deny  mapset.Set[string]
+	cfg   FilterConfig
+}
+
+func newFilter(cfg FilterConfig) *filter {
+	f := &filter{
+		allow: mapset.New[string](),
+		deny:  mapset.New[string](),
+		cfg:   cfg,
+	}
+
+	for _, asset := range cfg.Allow {
+		f.allow.Put(asset)
+	}
+
+	for asset := range cfg.MinAmounts {
+		f.allow.Put(asset)
+	}
+
+	for _, asset := range cfg.Deny {
+		f.deny.Put(asset)
+	}
+
+	return f
+}
+
+// check returns why the deposit is ignored, empty if it is accepted
+func (f *filter) check(output *core.Output) string {
+	if f.deny.Has(output.AssetID) {
+		return "asset denied"
+	}
+
+	if !f.allow.Has(output.AssetID) && f.cfg.Unknown == UnknownAssetIgnore {
+		return "unknown asset"
+	}
+
+	min, ok := f.cfg.MinAmounts[output.AssetID]
+	if !ok {
+		min = f.cfg.DefaultMinAmount
+	}
+
+	if output.Amount.LessThan(min) {
+		return "below minimum amount"
+	}
+
+	return ""
+}
@@ -0,0 +1,48 @@
+package syncer
+
+import (
+	"testing"
+
+	"github.com/pandodao/safe-wallet/core"
+	"github.com/shopspring/decimal"
+)
+
+func TestFilterCheck(t *testing.T) {
+	const (
+		btc  = "c6d0c728-2624-429b-8e0d-d9d19b6592fa"
+		xin  = "c94ac88f-4671-3976-b60a-09064f1811e8"
+		spam = "5b9d576b-1a8a-4e2b-9b14-6b1a4b1f5e63"
+		junk = "0a2f9d7e-6a8f-4a53-8a33-3c1b0e8c7c11"
+	)
+
+	f := newFilter(FilterConfig{
+		Allow:            []string{xin},
+		Deny:             []string{spam},
+		Unknown:          UnknownAssetIgnore,
+		MinAmounts:       map[string]decimal.Decimal{btc: decimal.RequireFromString("0.0001")},
+		DefaultMinAmount: decimal.RequireFromString("0.01"),
+	})
+
+	testCases := []struct {
+		name   string
+		asset  string
+		amount string
+		reason string
+	}{
+		{"Min amount", btc, "0.0001", ""},
+		{"Dust", btc, "0.00009", "below minimum amount"},
+		{"Default min amount", xin, "0.001", "below minimum amount"},
+		{"Allowed", xin, "1", ""},
+		{"Denied", spam, "100", "asset denied"},
+		{"Unknown", junk, "100", "unknown asset"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output := &core.Output{AssetID: tc.asset, Amount: decimal.RequireFromString(tc.amount)}
+			if reason := f.check(output); reason != tc.reason {
+				t.Errorf("expected %q, got %q", tc.reason, reason)
+			}
+		})
+	}
+}
@@ -6,32 +6,50 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/pandodao/safe-wallet/core"
+	"github.com/pandodao/safe-wallet/worker/checkpoint"
+	"github.com/zyedidia/generic/mapset"
 )
 
 const (
-	propertySyncOffset = "sync_offset"
+	// PropertySyncOffset is the property key of the next output sequence to pull
+	PropertySyncOffset = "sync_offset"
 )
 
+type Config struct {
+	Filter FilterConfig `valid:"-"`
+}
+
 func New(
 	outputz core.OutputService,
 	outputs core.OutputStore,
+	transfers core.TransferStore,
 	properties core.PropertyStore,
 	logger *slog.Logger,
+	cfg Config,
 ) *Syncer {
+	if _, err := govalidator.ValidateStruct(cfg); err != nil {
+		panic(err)
+	}
+
 	return &Syncer{
-		outputz:    outputz,
-		outputs:    outputs,
-		properties: properties,
-		logger:     logger.With("worker", "syncer"),
+		outputz:   outputz,
+		outputs:   outputs,
+		transfers: transfers,
+		cursor:    checkpoint.NewCursor(properties, PropertySyncOffset),
+		logger:    logger.With("worker", "syncer"),
+		filter:    newFilter(cfg.Filter),
 	}
 }
 
 type Syncer struct {
-	outputz    core.OutputService
-	outputs    core.OutputStore
-	properties core.PropertyStore
-	logger     *slog.Logger
+	outputz   core.OutputService
+	outputs   core.OutputStore
+	transfers core.TransferStore
+	cursor    *checkpoint.Cursor
+	logger    *slog.Logger
+	filter    *filter
 }
 
 func (w *Syncer) Run(ctx context.Context) error {
@@ -52,9 +70,10 @@ func (w *Syncer) Run(ctx context.Context) error {
 }
 
 func (w *Syncer) run(ctx context.Context) error {
-	var offset uint64
-	if err := w.properties.Get(ctx, propertySyncOffset, &offset); err != nil {
-		w.logger.Error("properties.Get", "err", err)
+	// reloaded every round, the version of the last write is compared with the next one
+	offset, err := w.cursor.Load(ctx)
+	if err != nil {
+		w.logger.Error("cursor.Load", "err", err)
 		return err
 	}
 
@@ -66,22 +85,144 @@ func (w *Syncer) run(ctx context.Context) error {
 	}
 
 	if len(outputs) > 0 {
-		w.logger.Info("list new outputs", "count", len(outputs), "offset", offset)
-
-		if err := w.outputs.Save(ctx, outputs); err != nil {
-			w.logger.Error("outputs.Save", "err", err)
+		inserted, err := w.save(ctx, outputs)
+		if err != nil {
 			return err
 		}
+
+		w.logger.Info("list new outputs", "count", len(outputs), "inserted", inserted, "offset", offset)
 	}
 
 	if nextOffset <= offset {
 		return fmt.Errorf("no new outputs")
 	}
 
-	if err := w.properties.Set(ctx, propertySyncOffset, nextOffset); err != nil {
-		w.logger.Error("properties.Set", "err", err)
+	if err := w.cursor.Advance(ctx, nextOffset); err != nil {
+		w.logger.Error("cursor.Advance", "err", err)
 		return err
 	}
 
 	return nil
 }
+
+// Backfill pulls the outputs of the sequence range again through the deposit
+// filters, the sync offset is left untouched. It returns the number of pulled
+// and inserted outputs.
+func (w *Syncer) Backfill(ctx context.Context, from, to uint64) (int, int, error) {
+	var pulled, inserted int
+	for offset := from; offset <= to; {
+		const limit = 500
+		outputs, next, err := w.outputz.Pull(ctx, offset, limit)
+		if err != nil {
+			w.logger.Error("outputz.Pull", "err", err)
+			return pulled, inserted, err
+		}
+
+		for idx, output := range outputs {
+			if output.Sequence > to {
+				outputs = outputs[:idx]
+				break
+			}
+		}
+
+		if len(outputs) > 0 {
+			pulled += len(outputs)
+
+			n, err := w.save(ctx, outputs)
+			if err != nil {
+				return pulled, inserted, err
+			}
+
+			inserted += n
+		}
+
+		if next <= offset {
+			break
+		}
+
+		offset = next
+	}
+
+	return pulled, inserted, nil
+}
+
+// save filters the outputs and saves the kept ones, the internal transfers are
+// linked to the receivers' outputs by their stored sequences
+func (w *Syncer) save(ctx context.Context, outputs []*core.Output) (int, error) {
+	outputs, deposits, err := w.filterOutputs(ctx, outputs)
+	if err != nil || len(outputs) == 0 {
+		return 0, err
+	}
+
+	inserted, err := w.outputs.Save(ctx, outputs)
+	if err != nil {
+		w.logger.Error("outputs.Save", "err", err)
+		return 0, err
+	}
+
+	// saved with the stored sequences of the outputs
+	if err := w.outputs.SaveDeposits(ctx, deposits); err != nil {
+		w.logger.Error("outputs.SaveDeposits", "err", err)
+		return 0, err
+	}
+
+	if err := w.transfers.LinkOutputs(ctx, outputs); err != nil {
+		w.logger.Error("transfers.LinkOutputs", "err", err)
+		return 0, err
+	}
+
+	return inserted, nil
+}
+
+// filterOutputs moves the spam & dust deposits to the ignored outputs and
+// returns the kept outputs and the kept deposits. Outputs of our own
+// transactions, like changes, and state changes are always kept.
+func (w *Syncer) filterOutputs(ctx context.Context, outputs []*core.Output) ([]*core.Output, []*core.Output, error) {
+	var hashes []string
+	for _, output := range outputs {
+		hashes = append(hashes, output.Hash.String())
+	}
+
+	spent, err := w.transfers.ListTxHashes(ctx, hashes)
+	if err != nil {
+		w.logger.Error("transfers.ListTxHashes", "err", err)
+		return nil, nil, err
+	}
+
+	own := mapset.New[string]()
+	for _, hash := range spent {
+		own.Put(hash)
+	}
+
+	var (
+		kept     []*core.Output
+		deposits []*core.Output
+		ignored  []*core.IgnoredOutput
+	)
+
+	for _, output := range outputs {
+		if output.State != core.OutputStateUnspent || own.Has(output.Hash.String()) {
+			kept = append(kept, output)
+			continue
+		}
+
+		if reason := w.filter.check(output); reason != "" {
+			ignored = append(ignored, &core.IgnoredOutput{Output: *output, Reason: reason})
+			continue
+		}
+
+		kept = append(kept, output)
+		deposits = append(deposits, output)
+	}
+
+	if len(ignored) > 0 {
+		if err := w.outputs.Ignore(ctx, ignored); err != nil {
+			w.logger.Error("outputs.Ignore", "err", err)
+			return nil, nil, err
+		}
+
+		w.logger.Info("deposits ignored", "count", len(ignored))
+	}
+
+	return kept, deposits, nil
+}